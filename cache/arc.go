@@ -0,0 +1,197 @@
+package cache
+
+import "github.com/rewantsoni/go-datastructures/hashmap"
+
+// ARC is the adaptive replacement cache of Megiddo and Modha. recent holds entries seen once and frequent
+// those seen at least twice; each has a ghost list remembering the keys it recently evicted, and a hit on a
+// ghost shifts target, the share of the capacity given to recent, towards the list that would have kept it.
+// Every size here is a weight, so with the default weigher this is the original count based algorithm.
+type ARC struct {
+	options Options
+	target  int
+
+	recent        *hashmap.LinkedHashMap
+	frequent      *hashmap.LinkedHashMap
+	recentGhost   *hashmap.LinkedHashMap
+	frequentGhost *hashmap.LinkedHashMap
+
+	recentWeight        int
+	frequentWeight      int
+	recentGhostWeight   int
+	frequentGhostWeight int
+
+	stats Stats
+}
+
+// NewARC returns nil for a non-positive capacity.
+func NewARC(options Options) *ARC {
+	if options.Capacity <= 0 {
+		return nil
+	}
+
+	// the ghost lists map each key to the weight it had when evicted
+	return &ARC{
+		options:       options,
+		recent:        hashmap.NewLinkedHashMap(hashmap.InsertionOrder, nil),
+		frequent:      hashmap.NewLinkedHashMap(hashmap.AccessOrder, nil),
+		recentGhost:   hashmap.NewLinkedHashMap(hashmap.InsertionOrder, nil),
+		frequentGhost: hashmap.NewLinkedHashMap(hashmap.InsertionOrder, nil),
+	}
+}
+
+func (c *ARC) Delete(key int) bool {
+	if w, ok := c.recentGhost.Delete(key); ok {
+		c.recentGhostWeight -= w
+	}
+	if w, ok := c.frequentGhost.Delete(key); ok {
+		c.frequentGhostWeight -= w
+	}
+	return c.removeResident(key)
+}
+
+func (c *ARC) Get(key int) (int, bool) {
+	if value, ok := c.recent.Delete(key); ok {
+		w := c.options.weigh(key, value)
+		c.recentWeight -= w
+		c.frequent.Put(key, value)
+		c.frequentWeight += w
+		c.stats.record(true)
+		return value, true
+	}
+
+	value, ok := c.frequent.Get(key)
+	c.stats.record(ok)
+	return value, ok
+}
+
+func (c *ARC) Len() int {
+	return c.recent.Size() + c.frequent.Size()
+}
+
+// Put returns false, leaving the cache untouched, if the entry alone weighs more than the capacity.
+func (c *ARC) Put(key int, value int) bool {
+	w := c.options.weigh(key, value)
+	if w > c.options.Capacity {
+		return false
+	}
+
+	switch {
+	case c.removeResident(key):
+		c.makeRoom(w, false)
+		c.frequent.Put(key, value)
+		c.frequentWeight += w
+
+	case c.recentGhost.ContainsKey(key):
+		c.target = min(c.options.Capacity, c.target+w*ratio(c.frequentGhostWeight, c.recentGhostWeight))
+		ghost, _ := c.recentGhost.Delete(key)
+		c.recentGhostWeight -= ghost
+		c.makeRoom(w, false)
+		c.frequent.Put(key, value)
+		c.frequentWeight += w
+
+	case c.frequentGhost.ContainsKey(key):
+		c.target = max(0, c.target-w*ratio(c.recentGhostWeight, c.frequentGhostWeight))
+		ghost, _ := c.frequentGhost.Delete(key)
+		c.frequentGhostWeight -= ghost
+		c.makeRoom(w, true)
+		c.frequent.Put(key, value)
+		c.frequentWeight += w
+
+	default:
+		c.makeRoom(w, false)
+		c.recent.Put(key, value)
+		c.recentWeight += w
+	}
+
+	c.trimGhosts()
+	return true
+}
+
+func (c *ARC) Stats() Stats {
+	return c.stats
+}
+
+func (c *ARC) Weight() int {
+	return c.recentWeight + c.frequentWeight
+}
+
+//Helper Functions
+func (c *ARC) removeResident(key int) bool {
+	if value, ok := c.recent.Delete(key); ok {
+		c.recentWeight -= c.options.weigh(key, value)
+		return true
+	}
+	if value, ok := c.frequent.Delete(key); ok {
+		c.frequentWeight -= c.options.weigh(key, value)
+		return true
+	}
+	return false
+}
+
+// makeRoom evicts until w more fits, taking from recent while it is over target and from frequent
+// otherwise. A hit in the frequent ghost list breaks the tie at target in favour of evicting from recent.
+func (c *ARC) makeRoom(w int, frequentGhostHit bool) {
+	for c.Weight()+w > c.options.Capacity {
+		fromRecent := c.recentWeight > c.target || (frequentGhostHit && c.recentWeight == c.target)
+		if c.recent.Empty() || (!fromRecent && !c.frequent.Empty()) {
+			c.evict(c.frequent, &c.frequentWeight, c.frequentGhost, &c.frequentGhostWeight)
+			continue
+		}
+		c.evict(c.recent, &c.recentWeight, c.recentGhost, &c.recentGhostWeight)
+	}
+}
+
+func (c *ARC) evict(from *hashmap.LinkedHashMap, weight *int, ghost *hashmap.LinkedHashMap, ghostWeight *int) {
+	key, value, _ := from.First()
+	from.Delete(key)
+	w := c.options.weigh(key, value)
+	*weight -= w
+
+	ghost.Put(key, w)
+	*ghostWeight += w
+
+	c.stats.Evictions++
+	c.options.evicted(key, value)
+}
+
+// trimGhosts keeps recent and its ghosts within the capacity, and everything together within twice that.
+func (c *ARC) trimGhosts() {
+	for c.recentWeight+c.recentGhostWeight > c.options.Capacity && !c.recentGhost.Empty() {
+		c.dropGhost(c.recentGhost, &c.recentGhostWeight)
+	}
+	for c.Weight()+c.recentGhostWeight+c.frequentGhostWeight > 2*c.options.Capacity {
+		if !c.frequentGhost.Empty() {
+			c.dropGhost(c.frequentGhost, &c.frequentGhostWeight)
+			continue
+		}
+		c.dropGhost(c.recentGhost, &c.recentGhostWeight)
+	}
+}
+
+func (c *ARC) dropGhost(ghost *hashmap.LinkedHashMap, ghostWeight *int) {
+	key, w, _ := ghost.First()
+	ghost.Delete(key)
+	*ghostWeight -= w
+}
+
+// ratio is how many times larger a is than b, at least one so that every ghost hit moves the target.
+func ratio(a, b int) int {
+	if b <= 0 {
+		return 1
+	}
+	return max(1, a/b)
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,73 @@
+package cache
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestARCPromotesOnSecondUse(t *testing.T) {
+	c := NewARC(Options{Capacity: 4})
+	c.Put(1, 10)
+	c.Put(2, 20)
+	c.Get(1)
+
+	assert.Equal(t, []int{2}, c.recent.Keys())
+	assert.Equal(t, []int{1}, c.frequent.Keys())
+}
+
+// a one-off scan larger than the cache must not flush entries that are used repeatedly, which is exactly
+// what an LRU of the same size does
+func TestARCResistsScans(t *testing.T) {
+	arc := NewARC(Options{Capacity: 10})
+	lru := NewLRU(Options{Capacity: 10})
+	for _, c := range []testWeightedCache{arc, lru} {
+		for key := 0; key < 5; key++ {
+			c.Put(key, key)
+			c.Get(key)
+		}
+		for key := 100; key < 150; key++ {
+			c.Put(key, key)
+		}
+	}
+
+	for key := 0; key < 5; key++ {
+		_, ok := arc.Get(key)
+		assert.True(t, ok, "arc lost frequently used key %d to a scan", key)
+		_, ok = lru.Get(key)
+		assert.False(t, ok)
+	}
+}
+
+func TestARCGhostHitsAdaptTarget(t *testing.T) {
+	c := NewARC(Options{Capacity: 2})
+	c.Put(1, 10)
+	c.Get(1)
+	c.Put(2, 20)
+	c.Put(3, 30)
+	assert.Equal(t, []int{2}, c.recentGhost.Keys())
+
+	c.Put(2, 21)
+	assert.Equal(t, 1, c.target, "a hit in the recent ghost list grows the recent target")
+	assert.Equal(t, []int{1}, c.frequentGhost.Keys())
+	v, ok := c.Get(2)
+	assert.Equal(t, []interface{}{21, true}, []interface{}{v, ok})
+
+	c.Put(1, 11)
+	assert.Equal(t, 0, c.target, "a hit in the frequent ghost list shrinks the recent target")
+	assert.Equal(t, []int{2, 1}, c.frequent.Keys())
+}
+
+func TestARCKeepsGhostsBounded(t *testing.T) {
+	c := NewARC(Options{Capacity: 8})
+	for key := 0; key < 1000; key++ {
+		c.Put(key, key)
+		if key%3 == 0 {
+			c.Get(key)
+		}
+
+		assert.LessOrEqual(t, c.recentWeight+c.recentGhostWeight, 8)
+		assert.LessOrEqual(t, c.Weight()+c.recentGhostWeight+c.frequentGhostWeight, 16)
+		assert.Equal(t, c.recentGhost.Size(), c.recentGhostWeight)
+		assert.Equal(t, c.frequentGhost.Size(), c.frequentGhostWeight)
+	}
+}
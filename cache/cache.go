@@ -0,0 +1,54 @@
+package cache
+
+type Cache interface {
+	Delete(key int) bool
+	Get(key int) (int, bool)
+	Len() int
+	Put(key int, value int) bool
+	Stats() Stats
+}
+
+type Stats struct {
+	Hits        int
+	Misses      int
+	Evictions   int
+	Expirations int
+}
+
+// Options configures every cache policy. Without a Weigher each entry weighs one and Capacity is a count.
+type Options struct {
+	Capacity int
+	// Weigher must give the same weight every time it is called with the same entry.
+	Weigher func(key, value int) int
+	// OnEvict is called for entries dropped to make room, not for ones removed with Delete or replaced by Put.
+	OnEvict func(key, value int)
+}
+
+func (s Stats) HitRatio() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+//Helper Functions
+func (o Options) weigh(key, value int) int {
+	if o.Weigher == nil {
+		return 1
+	}
+	return o.Weigher(key, value)
+}
+
+func (o Options) evicted(key, value int) {
+	if o.OnEvict != nil {
+		o.OnEvict(key, value)
+	}
+}
+
+func (s *Stats) record(hit bool) {
+	if hit {
+		s.Hits++
+		return
+	}
+	s.Misses++
+}
@@ -0,0 +1,183 @@
+package cache
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+type testWeightedCache interface {
+	Cache
+	Weight() int
+}
+
+var testCacheConstructors = map[string]func(options Options) testWeightedCache{
+	"lru": func(options Options) testWeightedCache {
+		return NewLRU(options)
+	},
+	"lfu": func(options Options) testWeightedCache {
+		return NewLFU(options)
+	},
+	"arc": func(options Options) testWeightedCache {
+		return NewARC(options)
+	},
+}
+
+func TestCreateNewCacheWithoutCapacity(t *testing.T) {
+	assert.Nil(t, NewLRU(Options{}))
+	assert.Nil(t, NewLFU(Options{Capacity: -1}))
+	assert.Nil(t, NewARC(Options{}))
+}
+
+func TestCacheOperations(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func(c Cache) []interface{}
+		expectedResult []interface{}
+	}{
+		{
+			name: "test get on empty cache is a miss",
+			actualResult: func(c Cache) []interface{} {
+				v, ok := c.Get(1)
+				return []interface{}{v, ok, c.Len(), c.Stats()}
+			},
+			expectedResult: []interface{}{-1, false, 0, Stats{Misses: 1}},
+		},
+		{
+			name: "test put then get is a hit",
+			actualResult: func(c Cache) []interface{} {
+				c.Put(1, 10)
+				c.Put(2, 20)
+				v, ok := c.Get(1)
+				return []interface{}{v, ok, c.Len(), c.Stats()}
+			},
+			expectedResult: []interface{}{10, true, 2, Stats{Hits: 1}},
+		},
+		{
+			name: "test put replaces the value",
+			actualResult: func(c Cache) []interface{} {
+				c.Put(1, 10)
+				c.Put(1, 11)
+				v, ok := c.Get(1)
+				return []interface{}{v, ok, c.Len()}
+			},
+			expectedResult: []interface{}{11, true, 1},
+		},
+		{
+			name: "test delete",
+			actualResult: func(c Cache) []interface{} {
+				c.Put(1, 10)
+				deleted := c.Delete(1)
+				_, ok := c.Get(1)
+				return []interface{}{deleted, c.Delete(1), ok, c.Len()}
+			},
+			expectedResult: []interface{}{true, false, false, 0},
+		},
+		{
+			name: "test capacity by count evicts one entry per overflow",
+			actualResult: func(c Cache) []interface{} {
+				for i := 0; i < 5; i++ {
+					c.Put(i, i)
+				}
+				return []interface{}{c.Len(), c.Stats().Evictions}
+			},
+			expectedResult: []interface{}{3, 2},
+		},
+	}
+	for name, constructor := range testCacheConstructors {
+		for _, testCase := range testCases {
+			t.Run(name+" "+testCase.name, func(t *testing.T) {
+				res := testCase.actualResult(constructor(Options{Capacity: 3}))
+				assert.Equal(t, testCase.expectedResult, res)
+			})
+		}
+	}
+}
+
+func TestCacheWeightAndEvictionCallback(t *testing.T) {
+	for name, constructor := range testCacheConstructors {
+		t.Run(name, func(t *testing.T) {
+			evicted := map[int]int{}
+			c := constructor(Options{
+				Capacity: 10,
+				Weigher: func(key, value int) int {
+					return value
+				},
+				OnEvict: func(key, value int) {
+					evicted[key] = value
+				},
+			})
+
+			assert.False(t, c.Put(1, 11), "an entry heavier than the capacity must be refused")
+			assert.True(t, c.Put(1, 4))
+			assert.True(t, c.Put(2, 4))
+			assert.Equal(t, 8, c.Weight())
+
+			assert.True(t, c.Put(3, 5))
+			assert.LessOrEqual(t, c.Weight(), 10)
+			assert.Equal(t, len(evicted), c.Stats().Evictions)
+			assert.NotEmpty(t, evicted)
+			for key, value := range evicted {
+				_, ok := c.Get(key)
+				assert.False(t, ok, "evicted key %d is still cached", key)
+				assert.Equal(t, 4, value)
+			}
+
+			c.Delete(3)
+			assert.Equal(t, len(evicted), c.Stats().Evictions, "delete must not count as an eviction")
+		})
+	}
+}
+
+// whatever the policy, the cache must never exceed its capacity, must return the latest value for every key
+// it still holds, and must report every entry it drops
+func TestCacheRandomOperations(t *testing.T) {
+	for name, constructor := range testCacheConstructors {
+		t.Run(name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(7))
+			latest := map[int]int{}
+			evictions := 0
+			c := constructor(Options{
+				Capacity: 40,
+				Weigher: func(key, value int) int {
+					return key%5 + 1
+				},
+				OnEvict: func(key, value int) {
+					assert.Equal(t, latest[key], value)
+					evictions++
+				},
+			})
+
+			for i := 0; i < 20000; i++ {
+				key := int(r.ExpFloat64() * 20)
+				switch r.Intn(4) {
+				case 0:
+					c.Delete(key)
+				case 1:
+					latest[key] = i
+					c.Put(key, i)
+				default:
+					if v, ok := c.Get(key); ok {
+						assert.Equal(t, latest[key], v)
+					}
+				}
+				assert.LessOrEqual(t, c.Weight(), 40)
+			}
+
+			weight := 0
+			for key := range latest {
+				if v, ok := c.Get(key); ok {
+					assert.Equal(t, latest[key], v)
+					weight += key%5 + 1
+				}
+			}
+			assert.Equal(t, weight, c.Weight())
+			assert.Equal(t, evictions, c.Stats().Evictions)
+		})
+	}
+}
+
+func TestStatsHitRatio(t *testing.T) {
+	assert.Equal(t, 0.0, Stats{}.HitRatio())
+	assert.Equal(t, 0.75, Stats{Hits: 3, Misses: 1}.HitRatio())
+}
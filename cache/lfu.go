@@ -0,0 +1,176 @@
+package cache
+
+import "github.com/rewantsoni/go-datastructures/hashmap"
+
+// LFU evicts the least frequently used entry, and among those the least recently used one. Entries sit in
+// buckets of equal use count that are linked in increasing count order, so both an access and an eviction
+// are O(1).
+type LFU struct {
+	options Options
+	weight  int
+	buckets map[int]*lfuBucket
+	lowest  *lfuBucket
+	stats   Stats
+}
+
+type lfuBucket struct {
+	count   int
+	entries *hashmap.LinkedHashMap
+	prev    *lfuBucket
+	next    *lfuBucket
+}
+
+// NewLFU returns nil for a non-positive capacity.
+func NewLFU(options Options) *LFU {
+	if options.Capacity <= 0 {
+		return nil
+	}
+
+	return &LFU{
+		options: options,
+		buckets: map[int]*lfuBucket{},
+	}
+}
+
+func (c *LFU) Delete(key int) bool {
+	b, ok := c.buckets[key]
+	if !ok {
+		return false
+	}
+
+	value := c.remove(b, key)
+	c.weight -= c.options.weigh(key, value)
+	return true
+}
+
+func (c *LFU) Get(key int) (int, bool) {
+	b, ok := c.buckets[key]
+	c.stats.record(ok)
+	if !ok {
+		return -1, false
+	}
+
+	value, _ := b.entries.Peek(key)
+	c.touch(b, key, value)
+	return value, true
+}
+
+func (c *LFU) Len() int {
+	return len(c.buckets)
+}
+
+// Put counts as a use of key if it is already cached. It returns false, leaving the cache untouched, if the
+// entry alone weighs more than the capacity.
+func (c *LFU) Put(key int, value int) bool {
+	w := c.options.weigh(key, value)
+	if w > c.options.Capacity {
+		return false
+	}
+
+	if b, ok := c.buckets[key]; ok {
+		old, _ := b.entries.Peek(key)
+		c.weight += w - c.options.weigh(key, old)
+		c.touch(b, key, value)
+		for c.weight > c.options.Capacity {
+			c.evict(key)
+		}
+		return true
+	}
+
+	for c.weight+w > c.options.Capacity {
+		c.evict(key)
+	}
+
+	b := c.lowest
+	if b == nil || b.count != 1 {
+		b = c.newBucket(1, nil, c.lowest)
+	}
+	b.entries.Put(key, value)
+	c.buckets[key] = b
+	c.weight += w
+	return true
+}
+
+func (c *LFU) Stats() Stats {
+	return c.stats
+}
+
+func (c *LFU) Weight() int {
+	return c.weight
+}
+
+//Helper Functions
+// evict drops the eldest entry of the lowest count, passing over protected, which is the entry being put.
+func (c *LFU) evict(protected int) {
+	for b := c.lowest; b != nil; b = b.next {
+		for it := b.entries.Iterator(); it.HasNext(); {
+			key, value := it.Next()
+			if key == protected {
+				continue
+			}
+
+			c.remove(b, key)
+			c.weight -= c.options.weigh(key, value)
+			c.stats.Evictions++
+			c.options.evicted(key, value)
+			return
+		}
+	}
+}
+
+// touch moves key from b into the bucket one count higher, storing value there.
+func (c *LFU) touch(b *lfuBucket, key int, value int) {
+	prev, next := b, b.next
+	if b.entries.Size() == 1 {
+		prev = b.prev
+	}
+	count := b.count + 1
+	c.remove(b, key)
+
+	target := next
+	if target == nil || target.count != count {
+		target = c.newBucket(count, prev, next)
+	}
+	target.entries.Put(key, value)
+	c.buckets[key] = target
+}
+
+func (c *LFU) remove(b *lfuBucket, key int) int {
+	value, _ := b.entries.Delete(key)
+	delete(c.buckets, key)
+
+	if b.entries.Empty() {
+		c.unlink(b)
+	}
+	return value
+}
+
+func (c *LFU) newBucket(count int, prev, next *lfuBucket) *lfuBucket {
+	b := &lfuBucket{
+		count:   count,
+		entries: hashmap.NewLinkedHashMap(hashmap.InsertionOrder, nil),
+		prev:    prev,
+		next:    next,
+	}
+
+	if prev == nil {
+		c.lowest = b
+	} else {
+		prev.next = b
+	}
+	if next != nil {
+		next.prev = b
+	}
+	return b
+}
+
+func (c *LFU) unlink(b *lfuBucket) {
+	if b.prev == nil {
+		c.lowest = b.next
+	} else {
+		b.prev.next = b.next
+	}
+	if b.next != nil {
+		b.next.prev = b.prev
+	}
+}
@@ -0,0 +1,104 @@
+package cache
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLFUEvictsLeastFrequentlyUsed(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func(c *LFU)
+		expectedResult []int
+	}{
+		{
+			name: "test lfu evicts the entry with the lowest use count",
+			actualResult: func(c *LFU) {
+				c.Put(1, 10)
+				c.Put(2, 20)
+				c.Put(3, 30)
+				c.Get(1)
+				c.Get(1)
+				c.Get(3)
+				c.Put(4, 40)
+			},
+			expectedResult: []int{2},
+		},
+		{
+			name: "test lfu breaks ties by recency",
+			actualResult: func(c *LFU) {
+				c.Put(1, 10)
+				c.Put(2, 20)
+				c.Put(3, 30)
+				c.Get(2)
+				c.Get(1)
+				c.Put(4, 40)
+				c.Put(5, 50)
+			},
+			expectedResult: []int{3, 4},
+		},
+		{
+			name: "test lfu put on a cached key counts as a use",
+			actualResult: func(c *LFU) {
+				c.Put(1, 10)
+				c.Put(2, 20)
+				c.Put(3, 30)
+				c.Put(1, 11)
+				c.Put(4, 40)
+			},
+			expectedResult: []int{2},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var evicted []int
+			c := NewLFU(Options{
+				Capacity: 3,
+				OnEvict: func(key, value int) {
+					evicted = append(evicted, key)
+				},
+			})
+			testCase.actualResult(c)
+			assert.Equal(t, testCase.expectedResult, evicted)
+		})
+	}
+}
+
+func TestLFUBucketsStayOrdered(t *testing.T) {
+	c := NewLFU(Options{Capacity: 4})
+	c.Put(1, 10)
+	c.Put(2, 20)
+	c.Put(3, 30)
+	for i := 0; i < 3; i++ {
+		c.Get(3)
+	}
+	c.Get(2)
+	c.Delete(1)
+
+	var counts []int
+	for b := c.lowest; b != nil; b = b.next {
+		if b.next != nil {
+			assert.Equal(t, b, b.next.prev)
+		}
+		counts = append(counts, b.count)
+	}
+	assert.Equal(t, []int{2, 4}, counts)
+}
+
+func TestLFUProtectsTheEntryBeingPut(t *testing.T) {
+	c := NewLFU(Options{
+		Capacity: 10,
+		Weigher: func(key, value int) int {
+			return value
+		},
+	})
+	c.Put(1, 2)
+	c.Put(2, 2)
+	c.Get(2)
+	c.Get(2)
+
+	c.Put(1, 9)
+	v, ok := c.Get(1)
+	assert.Equal(t, []interface{}{9, true}, []interface{}{v, ok})
+	assert.Equal(t, 1, c.Len())
+}
@@ -0,0 +1,70 @@
+package cache
+
+import "github.com/rewantsoni/go-datastructures/hashmap"
+
+// LRU evicts the least recently used entry, kept at the front of an access ordered LinkedHashMap.
+type LRU struct {
+	options Options
+	weight  int
+	entries *hashmap.LinkedHashMap
+	stats   Stats
+}
+
+// NewLRU returns nil for a non-positive capacity.
+func NewLRU(options Options) *LRU {
+	if options.Capacity <= 0 {
+		return nil
+	}
+
+	return &LRU{
+		options: options,
+		entries: hashmap.NewLinkedHashMap(hashmap.AccessOrder, nil),
+	}
+}
+
+func (c *LRU) Delete(key int) bool {
+	value, ok := c.entries.Delete(key)
+	if ok {
+		c.weight -= c.options.weigh(key, value)
+	}
+	return ok
+}
+
+func (c *LRU) Get(key int) (int, bool) {
+	value, ok := c.entries.Get(key)
+	c.stats.record(ok)
+	return value, ok
+}
+
+func (c *LRU) Len() int {
+	return c.entries.Size()
+}
+
+// Put returns false, leaving the cache untouched, if the entry alone weighs more than the capacity.
+func (c *LRU) Put(key int, value int) bool {
+	w := c.options.weigh(key, value)
+	if w > c.options.Capacity {
+		return false
+	}
+
+	c.Delete(key)
+	for c.weight+w > c.options.Capacity {
+		eldest, eldestValue, _ := c.entries.First()
+		c.entries.Delete(eldest)
+		c.weight -= c.options.weigh(eldest, eldestValue)
+		c.stats.Evictions++
+		c.options.evicted(eldest, eldestValue)
+	}
+
+	c.entries.Put(key, value)
+	c.weight += w
+	return true
+}
+
+func (c *LRU) Stats() Stats {
+	return c.stats
+}
+
+func (c *LRU) Weight() int {
+	return c.weight
+}
@@ -0,0 +1,27 @@
+package cache
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLRUEvictsLeastRecentlyUsed(t *testing.T) {
+	var evicted []int
+	c := NewLRU(Options{
+		Capacity: 3,
+		OnEvict: func(key, value int) {
+			evicted = append(evicted, key)
+		},
+	})
+
+	c.Put(1, 10)
+	c.Put(2, 20)
+	c.Put(3, 30)
+	c.Get(1)
+	c.Put(4, 40)
+	c.Put(2, 21)
+	c.Put(5, 50)
+
+	assert.Equal(t, []int{2, 3, 1}, evicted)
+	assert.Equal(t, []int{4, 2, 5}, c.entries.Keys())
+}
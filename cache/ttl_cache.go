@@ -0,0 +1,255 @@
+package cache
+
+import (
+	"container/heap"
+	"github.com/rewantsoni/go-datastructures/clock"
+	"sync"
+	"time"
+)
+
+// TTLCache drops entries once their time to live has passed. Every operation first removes whatever has
+// expired, found cheaply through a min-heap of deadlines; the optional sweeper does the same in the
+// background, sleeping until the earliest deadline, so expiry callbacks fire even when the cache is idle.
+type TTLCache struct {
+	mu        sync.Mutex
+	options   TTLOptions
+	entries   map[int]*ttlEntry
+	deadlines ttlEntries
+	stats     Stats
+
+	// closed and replaced whenever the earliest deadline moves earlier so that the sweeper can re-arm
+	earlier   chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+	sweeper   sync.WaitGroup
+}
+
+type TTLOptions struct {
+	// DefaultTTL applies to Put; zero or less means entries put that way never expire.
+	DefaultTTL time.Duration
+	// Sweep starts a background goroutine that expires entries as their deadlines pass; stop it with Close.
+	Sweep bool
+	// Clock defaults to the real clock.
+	Clock clock.Clock
+	// OnExpire is called, without the cache's lock held, for every entry that expires.
+	OnExpire func(key, value int)
+}
+
+type ttlEntry struct {
+	key      int
+	value    int
+	deadline time.Time
+	// position in deadlines, -1 for an entry that never expires
+	index int
+}
+
+type ttlEntries []*ttlEntry
+
+func NewTTLCache(options TTLOptions) *TTLCache {
+	if options.Clock == nil {
+		options.Clock = clock.New()
+	}
+
+	c := &TTLCache{
+		options: options,
+		entries: map[int]*ttlEntry{},
+		earlier: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	if options.Sweep {
+		c.sweeper.Add(1)
+		go c.sweep()
+	}
+	return c
+}
+
+// Close stops the sweeper, if any, and waits for it to exit. The cache stays usable with lazy expiry.
+func (c *TTLCache) Close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+	c.sweeper.Wait()
+}
+
+func (c *TTLCache) Delete(key int) bool {
+	c.mu.Lock()
+	expired := c.expireLocked()
+	e, ok := c.entries[key]
+	if ok {
+		c.removeLocked(e)
+	}
+	c.mu.Unlock()
+
+	c.notify(expired)
+	return ok
+}
+
+func (c *TTLCache) Get(key int) (int, bool) {
+	c.mu.Lock()
+	expired := c.expireLocked()
+	e, ok := c.entries[key]
+	c.stats.record(ok)
+	c.mu.Unlock()
+
+	c.notify(expired)
+	if !ok {
+		return -1, false
+	}
+	return e.value, true
+}
+
+func (c *TTLCache) Len() int {
+	c.mu.Lock()
+	expired := c.expireLocked()
+	n := len(c.entries)
+	c.mu.Unlock()
+
+	c.notify(expired)
+	return n
+}
+
+func (c *TTLCache) Put(key int, value int) bool {
+	return c.PutWithTTL(key, value, c.options.DefaultTTL)
+}
+
+// PutWithTTL replaces any existing entry for key, deadline included. A ttl of zero or less never expires.
+func (c *TTLCache) PutWithTTL(key int, value int, ttl time.Duration) bool {
+	c.mu.Lock()
+	expired := c.expireLocked()
+	if e, ok := c.entries[key]; ok {
+		c.removeLocked(e)
+	}
+
+	e := &ttlEntry{key: key, value: value, index: -1}
+	c.entries[key] = e
+	if ttl > 0 {
+		e.deadline = c.options.Clock.Now().Add(ttl)
+		heap.Push(&c.deadlines, e)
+		if e.index == 0 {
+			close(c.earlier)
+			c.earlier = make(chan struct{})
+		}
+	}
+	c.mu.Unlock()
+
+	c.notify(expired)
+	return true
+}
+
+func (c *TTLCache) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.stats
+}
+
+// TTL returns how long key has left to live, and false if it is absent or never expires.
+func (c *TTLCache) TTL(key int) (time.Duration, bool) {
+	c.mu.Lock()
+	expired := c.expireLocked()
+	e, ok := c.entries[key]
+	var ttl time.Duration
+	if ok && e.index != -1 {
+		ttl = e.deadline.Sub(c.options.Clock.Now())
+	}
+	c.mu.Unlock()
+
+	c.notify(expired)
+	return ttl, ok && e.index != -1
+}
+
+func (te ttlEntries) Len() int {
+	return len(te)
+}
+
+func (te ttlEntries) Less(i, j int) bool {
+	return te[i].deadline.Before(te[j].deadline)
+}
+
+func (te ttlEntries) Swap(i, j int) {
+	te[i], te[j] = te[j], te[i]
+	te[i].index = i
+	te[j].index = j
+}
+
+func (te *ttlEntries) Push(x interface{}) {
+	e := x.(*ttlEntry)
+	e.index = len(*te)
+	*te = append(*te, e)
+}
+
+func (te *ttlEntries) Pop() interface{} {
+	old := *te
+	e := old[len(old)-1]
+	old[len(old)-1] = nil
+	*te = old[:len(old)-1]
+	e.index = -1
+	return e
+}
+
+//Helper Functions
+// expireLocked removes every entry whose deadline has passed and returns them for notify.
+func (c *TTLCache) expireLocked() []*ttlEntry {
+	now := c.options.Clock.Now()
+
+	var expired []*ttlEntry
+	for len(c.deadlines) > 0 && !c.deadlines[0].deadline.After(now) {
+		e := heap.Pop(&c.deadlines).(*ttlEntry)
+		delete(c.entries, e.key)
+		c.stats.Expirations++
+		expired = append(expired, e)
+	}
+	return expired
+}
+
+func (c *TTLCache) removeLocked(e *ttlEntry) {
+	if e.index != -1 {
+		heap.Remove(&c.deadlines, e.index)
+	}
+	delete(c.entries, e.key)
+}
+
+func (c *TTLCache) notify(expired []*ttlEntry) {
+	if c.options.OnExpire == nil {
+		return
+	}
+	for _, e := range expired {
+		c.options.OnExpire(e.key, e.value)
+	}
+}
+
+func (c *TTLCache) sweep() {
+	defer c.sweeper.Done()
+
+	for {
+		c.mu.Lock()
+		expired := c.expireLocked()
+		earlier := c.earlier
+		var timer clock.Timer
+		if len(c.deadlines) > 0 {
+			timer = c.options.Clock.NewTimer(c.deadlines[0].deadline.Sub(c.options.Clock.Now()))
+		}
+		c.mu.Unlock()
+
+		c.notify(expired)
+
+		var fired <-chan time.Time
+		if timer != nil {
+			fired = timer.C()
+		}
+
+		select {
+		case <-fired:
+		case <-earlier:
+			if timer != nil {
+				timer.Stop()
+			}
+		case <-c.done:
+			if timer != nil {
+				timer.Stop()
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package cache
+
+import (
+	"github.com/rewantsoni/go-datastructures/clock"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+var testEpoch = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+const testWaitTimeout = 5 * time.Second
+
+func TestTTLCacheLazyExpiry(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func(c *TTLCache, fc *clock.FakeClock) []interface{}
+		expectedResult []interface{}
+	}{
+		{
+			name: "test entry is served until its default ttl passes",
+			actualResult: func(c *TTLCache, fc *clock.FakeClock) []interface{} {
+				c.Put(1, 10)
+				fc.Advance(59 * time.Second)
+				v, ok := c.Get(1)
+				fc.Advance(time.Second)
+				_, expiredOk := c.Get(1)
+				return []interface{}{v, ok, expiredOk, c.Len()}
+			},
+			expectedResult: []interface{}{10, true, false, 0},
+		},
+		{
+			name: "test per entry ttl overrides the default",
+			actualResult: func(c *TTLCache, fc *clock.FakeClock) []interface{} {
+				c.PutWithTTL(1, 10, time.Second)
+				c.PutWithTTL(2, 20, 2*time.Hour)
+				c.PutWithTTL(3, 30, 0)
+				fc.Advance(time.Hour)
+				_, ok1 := c.Get(1)
+				_, ok2 := c.Get(2)
+				_, ok3 := c.Get(3)
+				return []interface{}{ok1, ok2, ok3}
+			},
+			expectedResult: []interface{}{false, true, true},
+		},
+		{
+			name: "test put resets the deadline",
+			actualResult: func(c *TTLCache, fc *clock.FakeClock) []interface{} {
+				c.Put(1, 10)
+				fc.Advance(50 * time.Second)
+				c.Put(1, 11)
+				fc.Advance(50 * time.Second)
+				v, ok := c.Get(1)
+				ttl, hasTTL := c.TTL(1)
+				return []interface{}{v, ok, ttl, hasTTL}
+			},
+			expectedResult: []interface{}{11, true, 10 * time.Second, true},
+		},
+		{
+			name: "test ttl of absent and non expiring entries",
+			actualResult: func(c *TTLCache, fc *clock.FakeClock) []interface{} {
+				c.PutWithTTL(1, 10, -1)
+				ttl, ok := c.TTL(1)
+				absentTTL, absentOk := c.TTL(2)
+				return []interface{}{ttl, ok, absentTTL, absentOk}
+			},
+			expectedResult: []interface{}{time.Duration(0), false, time.Duration(0), false},
+		},
+		{
+			name: "test deleted entry does not expire later",
+			actualResult: func(c *TTLCache, fc *clock.FakeClock) []interface{} {
+				c.Put(1, 10)
+				c.Put(2, 20)
+				deleted := c.Delete(1)
+				fc.Advance(time.Minute)
+				return []interface{}{deleted, c.Delete(1), c.Len(), c.Stats()}
+			},
+			expectedResult: []interface{}{true, false, 0, Stats{Expirations: 1}},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			fc := clock.NewFakeClock(testEpoch)
+			c := NewTTLCache(TTLOptions{DefaultTTL: time.Minute, Clock: fc})
+			defer c.Close()
+
+			res := testCase.actualResult(c, fc)
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func TestTTLCacheExpiryCallbackAndStats(t *testing.T) {
+	fc := clock.NewFakeClock(testEpoch)
+	var expired [][]int
+	c := NewTTLCache(TTLOptions{
+		DefaultTTL: time.Minute,
+		Clock:      fc,
+		OnExpire: func(key, value int) {
+			expired = append(expired, []int{key, value})
+		},
+	})
+
+	c.PutWithTTL(1, 10, 2*time.Second)
+	c.PutWithTTL(2, 20, time.Second)
+	c.Put(3, 30)
+	c.Get(3)
+	fc.Advance(5 * time.Second)
+	c.Get(1)
+
+	assert.Equal(t, [][]int{{2, 20}, {1, 10}}, expired)
+	assert.Equal(t, Stats{Hits: 1, Misses: 1, Expirations: 2}, c.Stats())
+}
+
+func TestTTLCacheSweeperExpiresIdleEntries(t *testing.T) {
+	fc := clock.NewFakeClock(testEpoch)
+	expired := make(chan int, 2)
+	c := NewTTLCache(TTLOptions{
+		Sweep: true,
+		Clock: fc,
+		OnExpire: func(key, value int) {
+			expired <- key
+		},
+	})
+
+	c.PutWithTTL(1, 10, 10*time.Second)
+	fc.BlockUntil(1)
+
+	// an earlier deadline must re-arm the sweeper rather than wait behind the later one
+	c.PutWithTTL(2, 20, time.Second)
+	fc.Advance(time.Second)
+	select {
+	case key := <-expired:
+		assert.Equal(t, 2, key)
+	case <-time.After(testWaitTimeout):
+		t.Fatal("sweeper did not expire the entry")
+	}
+
+	fc.BlockUntil(1)
+	fc.Advance(9 * time.Second)
+	select {
+	case key := <-expired:
+		assert.Equal(t, 1, key)
+	case <-time.After(testWaitTimeout):
+		t.Fatal("sweeper did not expire the entry")
+	}
+
+	c.Close()
+	c.Close()
+	assert.Equal(t, 0, fc.PendingTimers())
+}
+
+func TestTTLCacheConcurrentUse(t *testing.T) {
+	c := NewTTLCache(TTLOptions{DefaultTTL: time.Millisecond, Sweep: true})
+	defer c.Close()
+
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 2000; i++ {
+				key := i % 50
+				switch i % 4 {
+				case 0:
+					c.Put(key, g)
+				case 1:
+					c.PutWithTTL(key, g, time.Duration(i%3)*time.Millisecond)
+				case 2:
+					c.Get(key)
+				case 3:
+					c.Delete(key)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, c.Len(), 50)
+}
@@ -0,0 +1,39 @@
+package clock
+
+import "time"
+
+type Clock interface {
+	NewTimer(d time.Duration) Timer
+	Now() time.Time
+}
+
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+}
+
+type realClock struct{}
+
+type realTimer struct {
+	t *time.Timer
+}
+
+func New() Clock {
+	return realClock{}
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return realTimer{t: time.NewTimer(d)}
+}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (rt realTimer) C() <-chan time.Time {
+	return rt.t.C
+}
+
+func (rt realTimer) Stop() bool {
+	return rt.t.Stop()
+}
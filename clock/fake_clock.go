@@ -0,0 +1,111 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// FakeClock only moves when told to, which makes code that waits on a Clock deterministic under test.
+type FakeClock struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	now    time.Time
+	timers []*fakeTimer
+}
+
+type fakeTimer struct {
+	fc       *FakeClock
+	deadline time.Time
+	c        chan time.Time
+}
+
+func NewFakeClock(now time.Time) *FakeClock {
+	fc := &FakeClock{now: now}
+	fc.cond = sync.NewCond(&fc.mu)
+	return fc
+}
+
+func (fc *FakeClock) Advance(d time.Duration) {
+	fc.Set(fc.Now().Add(d))
+}
+
+// BlockUntil waits until at least n timers are pending, so a test can be sure a goroutine is parked on the
+// clock before it advances it.
+func (fc *FakeClock) BlockUntil(n int) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	for len(fc.timers) < n {
+		fc.cond.Wait()
+	}
+}
+
+func (fc *FakeClock) NewTimer(d time.Duration) Timer {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	ft := &fakeTimer{
+		fc:       fc,
+		deadline: fc.now.Add(d),
+		c:        make(chan time.Time, 1),
+	}
+
+	if d <= 0 {
+		ft.c <- fc.now
+		return ft
+	}
+
+	fc.timers = append(fc.timers, ft)
+	fc.cond.Broadcast()
+	return ft
+}
+
+func (fc *FakeClock) Now() time.Time {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	return fc.now
+}
+
+func (fc *FakeClock) PendingTimers() int {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	return len(fc.timers)
+}
+
+func (fc *FakeClock) Set(now time.Time) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	fc.now = now
+
+	pending := fc.timers[:0]
+	for _, ft := range fc.timers {
+		if ft.deadline.After(now) {
+			pending = append(pending, ft)
+			continue
+		}
+		ft.c <- now
+	}
+	fc.timers = pending
+	fc.cond.Broadcast()
+}
+
+func (ft *fakeTimer) C() <-chan time.Time {
+	return ft.c
+}
+
+func (ft *fakeTimer) Stop() bool {
+	ft.fc.mu.Lock()
+	defer ft.fc.mu.Unlock()
+
+	for i, t := range ft.fc.timers {
+		if t == ft {
+			ft.fc.timers = append(ft.fc.timers[:i], ft.fc.timers[i+1:]...)
+			ft.fc.cond.Broadcast()
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package clock
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestFakeClockTimers(t *testing.T) {
+	epoch := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name            string
+		actualResult    func(fc *FakeClock) Timer
+		expectedFired   bool
+		expectedPending int
+	}{
+		{
+			name: "test timer fires once its deadline passes",
+			actualResult: func(fc *FakeClock) Timer {
+				timer := fc.NewTimer(time.Second)
+				fc.Advance(time.Second)
+				return timer
+			},
+			expectedFired:   true,
+			expectedPending: 0,
+		},
+		{
+			name: "test timer does not fire before its deadline",
+			actualResult: func(fc *FakeClock) Timer {
+				timer := fc.NewTimer(2 * time.Second)
+				fc.Advance(time.Second)
+				return timer
+			},
+			expectedFired:   false,
+			expectedPending: 1,
+		},
+		{
+			name: "test timer without duration fires immediately",
+			actualResult: func(fc *FakeClock) Timer {
+				return fc.NewTimer(0)
+			},
+			expectedFired:   true,
+			expectedPending: 0,
+		},
+		{
+			name: "test stopped timer never fires",
+			actualResult: func(fc *FakeClock) Timer {
+				timer := fc.NewTimer(time.Second)
+				assert.True(t, timer.Stop())
+				assert.False(t, timer.Stop())
+				fc.Advance(time.Hour)
+				return timer
+			},
+			expectedFired:   false,
+			expectedPending: 0,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			fc := NewFakeClock(epoch)
+			timer := testCase.actualResult(fc)
+
+			fired := false
+			select {
+			case <-timer.C():
+				fired = true
+			default:
+			}
+			assert.Equal(t, testCase.expectedFired, fired)
+			assert.Equal(t, testCase.expectedPending, fc.PendingTimers())
+		})
+	}
+}
+
+func TestFakeClockBlockUntil(t *testing.T) {
+	fc := NewFakeClock(time.Time{})
+
+	go fc.NewTimer(time.Second)
+	fc.BlockUntil(1)
+	assert.Equal(t, 1, fc.PendingTimers())
+
+	fc.Advance(time.Second)
+	assert.Equal(t, time.Time{}.Add(time.Second), fc.Now())
+	assert.Equal(t, 0, fc.PendingTimers())
+}
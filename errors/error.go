@@ -1 +1,12 @@
-package errors
\ No newline at end of file
+package errors
+
+import "errors"
+
+var (
+	ErrQueueClosed       = errors.New("queue is closed")
+	ErrStackFull         = errors.New("stack is full")
+	ErrNothingToUndo     = errors.New("nothing to undo")
+	ErrNothingToRedo     = errors.New("nothing to redo")
+	ErrTransactionActive = errors.New("transaction is in progress")
+	ErrNoTransaction     = errors.New("no transaction in progress")
+)
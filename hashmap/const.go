@@ -0,0 +1,12 @@
+package hashmap
+
+const (
+	upperLoadFactor = 0.75
+	lowerLoadFactor = 0.25
+	scalingFactor   = 2
+	initialCapacity = 16
+	nought          = 0
+
+	// 2^64 divided by the golden ratio, spreads consecutive keys across the table
+	fibonacciMultiplier = 0x9E3779B97F4A7C15
+)
@@ -0,0 +1,247 @@
+package hashmap
+
+import (
+	"fmt"
+	"github.com/rewantsoni/go-datastructures/iterator"
+	"math/bits"
+	"strings"
+)
+
+// HashMap is a Robin Hood open-addressing map: an insert takes the slot of any entry that is closer to its
+// home slot than the insert is to its own, which keeps probe sequences short and even. Deletion shifts the
+// following entries back instead of leaving tombstones.
+type HashMap struct {
+	capacity        int
+	upperLoadFactor float64
+	lowerLoadFactor float64
+	scalingFactor   int
+	size            int
+	shift           uint
+	slots           []slot
+}
+
+// dist is one more than the entry's distance from its home slot, so zero marks an empty slot.
+type slot struct {
+	key   int
+	value int
+	dist  int
+}
+
+type hashMapIterator struct {
+	currentIndex int
+	hm           *HashMap
+}
+
+func NewHashMap() *HashMap {
+	return newHashMap(upperLoadFactor, lowerLoadFactor)
+}
+
+// NewHashMapWithLoadFactors grows the map once it is more than upper full and shrinks it once it is at most
+// lower full. It returns nil unless 0 <= lower, lower*2 < upper and upper < 1, which keeps a resize from
+// immediately triggering the opposite one.
+func NewHashMapWithLoadFactors(upper, lower float64) *HashMap {
+	if lower < 0 || lower*scalingFactor >= upper || upper >= 1 {
+		return nil
+	}
+	return newHashMap(upper, lower)
+}
+
+func (hm *HashMap) Clear() {
+	hm.capacity = initialCapacity
+	hm.size = nought
+	hm.shift = shiftFor(initialCapacity)
+	hm.slots = make([]slot, initialCapacity)
+}
+
+func (hm *HashMap) ContainsKey(key int) bool {
+	return hm.find(key) != -1
+}
+
+// Delete removes key and returns its value, or -1 and false if it was not present.
+func (hm *HashMap) Delete(key int) (int, bool) {
+	i := hm.find(key)
+	if i == -1 {
+		return -1, false
+	}
+
+	value := hm.slots[i].value
+	hm.removeAt(i)
+	hm.checkAndDecreaseLimit()
+	return value, true
+}
+
+func (hm *HashMap) Empty() bool {
+	return hm.Size() == 0
+}
+
+func (hm *HashMap) Get(key int) (int, bool) {
+	i := hm.find(key)
+	if i == -1 {
+		return -1, false
+	}
+	return hm.slots[i].value, true
+}
+
+// Iterator visits the entries in table order, which is unrelated to insertion order.
+func (hm *HashMap) Iterator() iterator.MapIterator {
+	return newHashMapIterator(hm)
+}
+
+func (hm *HashMap) Keys() []int {
+	keys := make([]int, nought, hm.Size())
+	for _, s := range hm.slots {
+		if s.dist != 0 {
+			keys = append(keys, s.key)
+		}
+	}
+	return keys
+}
+
+// Put maps key to value and returns the value it replaced, or -1 and false if key is new.
+func (hm *HashMap) Put(key int, value int) (int, bool) {
+	if i := hm.find(key); i != -1 {
+		old := hm.slots[i].value
+		hm.slots[i].value = value
+		return old, true
+	}
+
+	hm.checkAndIncreaseLimit()
+	hm.insert(key, value)
+	return -1, false
+}
+
+func (hm *HashMap) Size() int {
+	return hm.size
+}
+
+func (hm *HashMap) String() string {
+	sb := strings.Builder{}
+	for it := hm.Iterator(); it.HasNext(); {
+		key, value := it.Next()
+		sb.WriteString(fmt.Sprintf("%d=%d ", key, value))
+	}
+	return sb.String()
+}
+
+// Values are listed in the same order as Keys.
+func (hm *HashMap) Values() []int {
+	values := make([]int, nought, hm.Size())
+	for _, s := range hm.slots {
+		if s.dist != 0 {
+			values = append(values, s.value)
+		}
+	}
+	return values
+}
+
+func (hmi *hashMapIterator) HasNext() bool {
+	for hmi.currentIndex < len(hmi.hm.slots) && hmi.hm.slots[hmi.currentIndex].dist == 0 {
+		hmi.currentIndex++
+	}
+	return hmi.currentIndex < len(hmi.hm.slots)
+}
+
+func (hmi *hashMapIterator) Next() (int, int) {
+	if !hmi.HasNext() {
+		panic("panic: hash map iterator is exhausted")
+	}
+
+	s := hmi.hm.slots[hmi.currentIndex]
+	hmi.currentIndex++
+	return s.key, s.value
+}
+
+//Helper Functions
+func newHashMap(upper, lower float64) *HashMap {
+	hm := &HashMap{
+		upperLoadFactor: upper,
+		lowerLoadFactor: lower,
+		scalingFactor:   scalingFactor,
+	}
+	hm.Clear()
+	return hm
+}
+
+func (hm *HashMap) checkAndIncreaseLimit() {
+	if hm.Size()+1 > int(float64(hm.capacity)*hm.upperLoadFactor) {
+		hm.resize(hm.capacity * hm.scalingFactor)
+	}
+}
+
+func (hm *HashMap) checkAndDecreaseLimit() {
+	if hm.Size() <= int(float64(hm.capacity)*hm.lowerLoadFactor) && hm.capacity != initialCapacity {
+		hm.resize(hm.capacity / hm.scalingFactor)
+	}
+}
+
+func (hm *HashMap) resize(capacity int) {
+	old := hm.slots
+	hm.capacity = capacity
+	hm.size = nought
+	hm.shift = shiftFor(capacity)
+	hm.slots = make([]slot, capacity)
+
+	for _, s := range old {
+		if s.dist != 0 {
+			hm.insert(s.key, s.value)
+		}
+	}
+}
+
+func (hm *HashMap) home(key int) int {
+	return int((uint64(key) * fibonacciMultiplier) >> hm.shift)
+}
+
+// find stops as soon as it reaches an entry closer to home than key would be, since Robin Hood insertion
+// would have placed key before it.
+func (hm *HashMap) find(key int) int {
+	mask := hm.capacity - 1
+	for i, dist := hm.home(key), 1; ; i, dist = (i+1)&mask, dist+1 {
+		s := &hm.slots[i]
+		if s.dist < dist {
+			return -1
+		}
+		if s.key == key {
+			return i
+		}
+	}
+}
+
+// insert assumes key is absent and there is a free slot.
+func (hm *HashMap) insert(key int, value int) {
+	mask := hm.capacity - 1
+	entry := slot{key: key, value: value, dist: 1}
+	for i := hm.home(key); ; i = (i + 1) & mask {
+		s := &hm.slots[i]
+		if s.dist == 0 {
+			*s = entry
+			hm.size++
+			return
+		}
+		if s.dist < entry.dist {
+			*s, entry = entry, *s
+		}
+		entry.dist++
+	}
+}
+
+func (hm *HashMap) removeAt(i int) {
+	mask := hm.capacity - 1
+	for next := (i + 1) & mask; hm.slots[next].dist > 1; i, next = next, (next+1)&mask {
+		hm.slots[i] = hm.slots[next]
+		hm.slots[i].dist--
+	}
+	hm.slots[i] = slot{}
+	hm.size--
+}
+
+func shiftFor(capacity int) uint {
+	return uint(64 - bits.TrailingZeros(uint(capacity)))
+}
+
+func newHashMapIterator(hm *HashMap) *hashMapIterator {
+	return &hashMapIterator{
+		currentIndex: 0,
+		hm:           hm,
+	}
+}
@@ -0,0 +1,286 @@
+package hashmap
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestCreateNewHashMap(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func() *HashMap
+		expectedResult *HashMap
+	}{
+		{
+			name:           "test create new hash map",
+			actualResult:   NewHashMap,
+			expectedResult: newHashMap(upperLoadFactor, lowerLoadFactor),
+		},
+		{
+			name: "test create new hash map with load factors",
+			actualResult: func() *HashMap {
+				return NewHashMapWithLoadFactors(0.9, 0.1)
+			},
+			expectedResult: newHashMap(0.9, 0.1),
+		},
+		{
+			name: "test create new hash map with upper load factor of one",
+			actualResult: func() *HashMap {
+				return NewHashMapWithLoadFactors(1, 0.1)
+			},
+			expectedResult: nil,
+		},
+		{
+			name: "test create new hash map with load factors that would thrash",
+			actualResult: func() *HashMap {
+				return NewHashMapWithLoadFactors(0.75, 0.4)
+			},
+			expectedResult: nil,
+		},
+		{
+			name: "test create new hash map with negative lower load factor",
+			actualResult: func() *HashMap {
+				return NewHashMapWithLoadFactors(0.75, -0.1)
+			},
+			expectedResult: nil,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := testCase.actualResult()
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func TestHashMapOperations(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func(hm *HashMap) []interface{}
+		expectedResult []interface{}
+	}{
+		{
+			name: "test get and delete on empty hash map",
+			actualResult: func(hm *HashMap) []interface{} {
+				v, ok := hm.Get(1)
+				d, dok := hm.Delete(1)
+				return []interface{}{v, ok, d, dok, hm.ContainsKey(1), hm.Empty()}
+			},
+			expectedResult: []interface{}{-1, false, -1, false, false, true},
+		},
+		{
+			name: "test put returns the replaced value",
+			actualResult: func(hm *HashMap) []interface{} {
+				old, ok := hm.Put(1, 10)
+				replaced, rok := hm.Put(1, 11)
+				v, _ := hm.Get(1)
+				return []interface{}{old, ok, replaced, rok, v, hm.Size()}
+			},
+			expectedResult: []interface{}{-1, false, 10, true, 11, 1},
+		},
+		{
+			name: "test delete returns the removed value",
+			actualResult: func(hm *HashMap) []interface{} {
+				hm.Put(1, 10)
+				hm.Put(2, 20)
+				d, ok := hm.Delete(1)
+				return []interface{}{d, ok, hm.ContainsKey(1), hm.ContainsKey(2), hm.Size()}
+			},
+			expectedResult: []interface{}{10, true, false, true, 1},
+		},
+		{
+			name: "test negative and zero keys",
+			actualResult: func(hm *HashMap) []interface{} {
+				hm.Put(0, 1)
+				hm.Put(-1, 2)
+				a, _ := hm.Get(0)
+				b, _ := hm.Get(-1)
+				return []interface{}{a, b}
+			},
+			expectedResult: []interface{}{1, 2},
+		},
+		{
+			name: "test clear",
+			actualResult: func(hm *HashMap) []interface{} {
+				for i := 0; i < 100; i++ {
+					hm.Put(i, i)
+				}
+				hm.Clear()
+				return []interface{}{hm.Size(), hm.capacity, hm.ContainsKey(5)}
+			},
+			expectedResult: []interface{}{0, initialCapacity, false},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := testCase.actualResult(NewHashMap())
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func TestHashMapKeysValuesAndIterator(t *testing.T) {
+	hm := NewHashMap()
+	for i := 1; i <= 20; i++ {
+		hm.Put(i, i*10)
+	}
+	hm.Delete(7)
+
+	keys, values := hm.Keys(), hm.Values()
+	for i := range keys {
+		assert.Equal(t, keys[i]*10, values[i], "values must line up with keys")
+	}
+
+	var iterated []int
+	for it := hm.Iterator(); it.HasNext(); {
+		key, value := it.Next()
+		assert.Equal(t, key*10, value)
+		iterated = append(iterated, key)
+	}
+	assert.Equal(t, keys, iterated)
+
+	sort.Ints(keys)
+	expected := []int{1, 2, 3, 4, 5, 6, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}
+	assert.Equal(t, expected, keys)
+}
+
+func TestHashMapIteratorPanicsWhenExhausted(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("didn't panic on exhausted iterator")
+		}
+	}()
+	NewHashMap().Iterator().Next()
+}
+
+func TestHashMapGrowsAndShrinks(t *testing.T) {
+	hm := NewHashMap()
+	for i := 0; i < 1000; i++ {
+		hm.Put(i, i)
+	}
+	assert.Equal(t, 2048, hm.capacity)
+
+	for i := 0; i < 1000; i++ {
+		hm.Delete(i)
+	}
+	assert.Equal(t, initialCapacity, hm.capacity)
+	assert.True(t, hm.Empty())
+}
+
+// the map must agree with the builtin map through a random mix of operations, and the Robin Hood invariant
+// must hold after each one
+func TestHashMapMatchesBuiltinMap(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	hm := NewHashMap()
+	expected := map[int]int{}
+
+	for i := 0; i < 20000; i++ {
+		key := r.Intn(500) - 250
+		switch r.Intn(3) {
+		case 0, 1:
+			old, ok := hm.Put(key, i)
+			eold, eok := expected[key]
+			if !eok {
+				eold = -1
+			}
+			assert.Equal(t, []interface{}{eold, eok}, []interface{}{old, ok})
+			expected[key] = i
+		case 2:
+			old, ok := hm.Delete(key)
+			eold, eok := expected[key]
+			if !eok {
+				eold = -1
+			}
+			assert.Equal(t, []interface{}{eold, eok}, []interface{}{old, ok})
+			delete(expected, key)
+		}
+
+		if i%1000 == 0 {
+			testCheckInvariant(t, hm)
+		}
+	}
+
+	assert.Equal(t, len(expected), hm.Size())
+	for key, value := range expected {
+		v, ok := hm.Get(key)
+		assert.True(t, ok)
+		assert.Equal(t, value, v)
+	}
+	testCheckInvariant(t, hm)
+}
+
+func testCheckInvariant(t *testing.T, hm *HashMap) {
+	mask := hm.capacity - 1
+	size := 0
+	for i, s := range hm.slots {
+		if s.dist == 0 {
+			continue
+		}
+		size++
+		assert.Equal(t, i, (hm.home(s.key)+s.dist-1)&mask, "slot %d records the wrong distance", i)
+
+		prev := hm.slots[(i-1)&mask]
+		assert.LessOrEqual(t, s.dist, prev.dist+1, "slot %d is further from home than robin hood allows", i)
+	}
+	assert.Equal(t, size, hm.Size())
+}
+
+const benchmarkKeys = 1 << 16
+
+func BenchmarkHashMapPut(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		hm := NewHashMap()
+		for k := 0; k < benchmarkKeys; k++ {
+			hm.Put(k*7, k)
+		}
+	}
+}
+
+func BenchmarkBuiltinMapPut(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		m := map[int]int{}
+		for k := 0; k < benchmarkKeys; k++ {
+			m[k*7] = k
+		}
+	}
+}
+
+func BenchmarkHashMapGet(b *testing.B) {
+	hm := NewHashMap()
+	for k := 0; k < benchmarkKeys; k++ {
+		hm.Put(k*7, k)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		hm.Get((i % (2 * benchmarkKeys)) * 7)
+	}
+}
+
+func BenchmarkBuiltinMapGet(b *testing.B) {
+	m := map[int]int{}
+	for k := 0; k < benchmarkKeys; k++ {
+		m[k*7] = k
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m[(i%(2*benchmarkKeys))*7]
+	}
+}
+
+func BenchmarkHashMapPutDelete(b *testing.B) {
+	hm := NewHashMap()
+	for i := 0; i < b.N; i++ {
+		hm.Put(i%benchmarkKeys, i)
+		hm.Delete((i + benchmarkKeys/2) % benchmarkKeys)
+	}
+}
+
+func BenchmarkBuiltinMapPutDelete(b *testing.B) {
+	m := map[int]int{}
+	for i := 0; i < b.N; i++ {
+		m[i%benchmarkKeys] = i
+		delete(m, (i+benchmarkKeys/2)%benchmarkKeys)
+	}
+}
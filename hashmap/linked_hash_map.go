@@ -0,0 +1,255 @@
+package hashmap
+
+import (
+	"fmt"
+	"github.com/rewantsoni/go-datastructures/iterator"
+	"strings"
+)
+
+type Ordering int
+
+const (
+	// InsertionOrder keeps keys in the order they were first put; re-putting a key does not move it.
+	InsertionOrder Ordering = iota
+	// AccessOrder moves a key to the back whenever it is put or read with Get.
+	AccessOrder
+)
+
+// none marks the end of the entry list and of the free list.
+const none = -1
+
+// LinkedHashMap threads its entries on a doubly linked list so that iteration follows insertion or access
+// order. Entries live in a slice and link to each other by index, which lets the HashMap index them by
+// position; freed positions are reused through a free list threaded on next.
+type LinkedHashMap struct {
+	index        *HashMap
+	entries      []linkedEntry
+	head         int
+	tail         int
+	free         int
+	ordering     Ordering
+	removeEldest func(key, value int) bool
+}
+
+type linkedEntry struct {
+	key   int
+	value int
+	prev  int
+	next  int
+}
+
+type linkedHashMapIterator struct {
+	current int
+	lhm     *LinkedHashMap
+}
+
+// NewLinkedHashMap takes an optional removeEldest hook, called with the eldest entry after every put that
+// adds a key; the eldest entry is removed if it returns true.
+func NewLinkedHashMap(ordering Ordering, removeEldest func(key, value int) bool) *LinkedHashMap {
+	lhm := &LinkedHashMap{
+		ordering:     ordering,
+		removeEldest: removeEldest,
+	}
+	lhm.Clear()
+	return lhm
+}
+
+func (lhm *LinkedHashMap) Clear() {
+	lhm.index = NewHashMap()
+	lhm.entries = make([]linkedEntry, nought, initialCapacity)
+	lhm.head = none
+	lhm.tail = none
+	lhm.free = none
+}
+
+func (lhm *LinkedHashMap) ContainsKey(key int) bool {
+	return lhm.index.ContainsKey(key)
+}
+
+func (lhm *LinkedHashMap) Delete(key int) (int, bool) {
+	i, ok := lhm.index.Delete(key)
+	if !ok {
+		return -1, false
+	}
+
+	value := lhm.entries[i].value
+	lhm.unlink(i)
+	lhm.release(i)
+	return value, true
+}
+
+func (lhm *LinkedHashMap) Empty() bool {
+	return lhm.Size() == 0
+}
+
+// First returns the eldest entry: the least recently inserted or accessed one, depending on the ordering.
+func (lhm *LinkedHashMap) First() (int, int, bool) {
+	return lhm.entryAt(lhm.head)
+}
+
+// Get counts as an access under AccessOrder.
+func (lhm *LinkedHashMap) Get(key int) (int, bool) {
+	i, ok := lhm.index.Get(key)
+	if !ok {
+		return -1, false
+	}
+
+	if lhm.ordering == AccessOrder {
+		lhm.moveToBack(i)
+	}
+	return lhm.entries[i].value, true
+}
+
+// Iterator goes from the eldest entry to the youngest.
+func (lhm *LinkedHashMap) Iterator() iterator.MapIterator {
+	return newLinkedHashMapIterator(lhm)
+}
+
+func (lhm *LinkedHashMap) Keys() []int {
+	keys := make([]int, nought, lhm.Size())
+	for i := lhm.head; i != none; i = lhm.entries[i].next {
+		keys = append(keys, lhm.entries[i].key)
+	}
+	return keys
+}
+
+func (lhm *LinkedHashMap) Last() (int, int, bool) {
+	return lhm.entryAt(lhm.tail)
+}
+
+func (lhm *LinkedHashMap) Ordering() Ordering {
+	return lhm.ordering
+}
+
+// Peek reads a value without counting as an access.
+func (lhm *LinkedHashMap) Peek(key int) (int, bool) {
+	i, ok := lhm.index.Get(key)
+	if !ok {
+		return -1, false
+	}
+	return lhm.entries[i].value, true
+}
+
+func (lhm *LinkedHashMap) Put(key int, value int) (int, bool) {
+	if i, ok := lhm.index.Get(key); ok {
+		old := lhm.entries[i].value
+		lhm.entries[i].value = value
+		if lhm.ordering == AccessOrder {
+			lhm.moveToBack(i)
+		}
+		return old, true
+	}
+
+	i := lhm.allocate(key, value)
+	lhm.index.Put(key, i)
+	lhm.linkLast(i)
+
+	if lhm.removeEldest != nil {
+		eldest := lhm.entries[lhm.head]
+		if lhm.removeEldest(eldest.key, eldest.value) {
+			lhm.Delete(eldest.key)
+		}
+	}
+	return -1, false
+}
+
+func (lhm *LinkedHashMap) Size() int {
+	return lhm.index.Size()
+}
+
+func (lhm *LinkedHashMap) String() string {
+	sb := strings.Builder{}
+	for it := lhm.Iterator(); it.HasNext(); {
+		key, value := it.Next()
+		sb.WriteString(fmt.Sprintf("%d=%d ", key, value))
+	}
+	return sb.String()
+}
+
+func (lhm *LinkedHashMap) Values() []int {
+	values := make([]int, nought, lhm.Size())
+	for i := lhm.head; i != none; i = lhm.entries[i].next {
+		values = append(values, lhm.entries[i].value)
+	}
+	return values
+}
+
+func (lhmi *linkedHashMapIterator) HasNext() bool {
+	return lhmi.current != none
+}
+
+func (lhmi *linkedHashMapIterator) Next() (int, int) {
+	if lhmi.current == none {
+		panic("panic: linked hash map iterator is exhausted")
+	}
+
+	e := lhmi.lhm.entries[lhmi.current]
+	lhmi.current = e.next
+	return e.key, e.value
+}
+
+//Helper Functions
+func (lhm *LinkedHashMap) allocate(key int, value int) int {
+	e := linkedEntry{key: key, value: value, prev: none, next: none}
+	if lhm.free == none {
+		lhm.entries = append(lhm.entries, e)
+		return len(lhm.entries) - 1
+	}
+
+	i := lhm.free
+	lhm.free = lhm.entries[i].next
+	lhm.entries[i] = e
+	return i
+}
+
+func (lhm *LinkedHashMap) release(i int) {
+	lhm.entries[i] = linkedEntry{prev: none, next: lhm.free}
+	lhm.free = i
+}
+
+func (lhm *LinkedHashMap) linkLast(i int) {
+	lhm.entries[i].prev = lhm.tail
+	lhm.entries[i].next = none
+	if lhm.tail == none {
+		lhm.head = i
+	} else {
+		lhm.entries[lhm.tail].next = i
+	}
+	lhm.tail = i
+}
+
+func (lhm *LinkedHashMap) unlink(i int) {
+	prev, next := lhm.entries[i].prev, lhm.entries[i].next
+	if prev == none {
+		lhm.head = next
+	} else {
+		lhm.entries[prev].next = next
+	}
+	if next == none {
+		lhm.tail = prev
+	} else {
+		lhm.entries[next].prev = prev
+	}
+}
+
+func (lhm *LinkedHashMap) moveToBack(i int) {
+	if lhm.tail == i {
+		return
+	}
+	lhm.unlink(i)
+	lhm.linkLast(i)
+}
+
+func (lhm *LinkedHashMap) entryAt(i int) (int, int, bool) {
+	if i == none {
+		return -1, -1, false
+	}
+	return lhm.entries[i].key, lhm.entries[i].value, true
+}
+
+func newLinkedHashMapIterator(lhm *LinkedHashMap) *linkedHashMapIterator {
+	return &linkedHashMapIterator{
+		current: lhm.head,
+		lhm:     lhm,
+	}
+}
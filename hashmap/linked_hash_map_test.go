@@ -0,0 +1,202 @@
+package hashmap
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+func testLinkedHashMapEntries(lhm *LinkedHashMap) [][]int {
+	var entries [][]int
+	for it := lhm.Iterator(); it.HasNext(); {
+		key, value := it.Next()
+		entries = append(entries, []int{key, value})
+	}
+	return entries
+}
+
+func TestLinkedHashMapOrdering(t *testing.T) {
+	testCases := []struct {
+		name           string
+		ordering       Ordering
+		actualResult   func(lhm *LinkedHashMap) [][]int
+		expectedResult [][]int
+	}{
+		{
+			name:     "test insertion order ignores gets and re-puts",
+			ordering: InsertionOrder,
+			actualResult: func(lhm *LinkedHashMap) [][]int {
+				lhm.Put(3, 30)
+				lhm.Put(1, 10)
+				lhm.Put(2, 20)
+				lhm.Get(3)
+				lhm.Put(3, 31)
+				return testLinkedHashMapEntries(lhm)
+			},
+			expectedResult: [][]int{{3, 31}, {1, 10}, {2, 20}},
+		},
+		{
+			name:     "test access order moves gets and re-puts to the back",
+			ordering: AccessOrder,
+			actualResult: func(lhm *LinkedHashMap) [][]int {
+				lhm.Put(3, 30)
+				lhm.Put(1, 10)
+				lhm.Put(2, 20)
+				lhm.Get(3)
+				lhm.Put(1, 11)
+				return testLinkedHashMapEntries(lhm)
+			},
+			expectedResult: [][]int{{2, 20}, {3, 30}, {1, 11}},
+		},
+		{
+			name:     "test peek does not count as an access",
+			ordering: AccessOrder,
+			actualResult: func(lhm *LinkedHashMap) [][]int {
+				lhm.Put(1, 10)
+				lhm.Put(2, 20)
+				lhm.Peek(1)
+				lhm.ContainsKey(1)
+				return testLinkedHashMapEntries(lhm)
+			},
+			expectedResult: [][]int{{1, 10}, {2, 20}},
+		},
+		{
+			name:     "test delete unlinks from the middle and reuses the slot",
+			ordering: InsertionOrder,
+			actualResult: func(lhm *LinkedHashMap) [][]int {
+				lhm.Put(1, 10)
+				lhm.Put(2, 20)
+				lhm.Put(3, 30)
+				lhm.Delete(2)
+				lhm.Put(4, 40)
+				return append(testLinkedHashMapEntries(lhm), []int{len(lhm.entries)})
+			},
+			expectedResult: [][]int{{1, 10}, {3, 30}, {4, 40}, {3}},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := testCase.actualResult(NewLinkedHashMap(testCase.ordering, nil))
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func TestLinkedHashMapFirstLastKeysValues(t *testing.T) {
+	lhm := NewLinkedHashMap(InsertionOrder, nil)
+	_, _, ok := lhm.First()
+	assert.False(t, ok)
+	_, _, ok = lhm.Last()
+	assert.False(t, ok)
+
+	lhm.Put(5, 50)
+	lhm.Put(6, 60)
+	lhm.Put(7, 70)
+
+	key, value, ok := lhm.First()
+	assert.Equal(t, []interface{}{5, 50, true}, []interface{}{key, value, ok})
+	key, value, ok = lhm.Last()
+	assert.Equal(t, []interface{}{7, 70, true}, []interface{}{key, value, ok})
+	assert.Equal(t, []int{5, 6, 7}, lhm.Keys())
+	assert.Equal(t, []int{50, 60, 70}, lhm.Values())
+	assert.Equal(t, "5=50 6=60 7=70 ", lhm.String())
+
+	lhm.Delete(5)
+	lhm.Delete(7)
+	key, _, _ = lhm.First()
+	last, _, _ := lhm.Last()
+	assert.Equal(t, []int{6, 6}, []int{key, last})
+
+	lhm.Clear()
+	assert.True(t, lhm.Empty())
+	assert.Nil(t, testLinkedHashMapEntries(lhm))
+}
+
+func TestLinkedHashMapRemoveEldest(t *testing.T) {
+	var lhm *LinkedHashMap
+	var evicted []int
+	lhm = NewLinkedHashMap(AccessOrder, func(key, value int) bool {
+		if lhm.Size() > 2 {
+			evicted = append(evicted, key)
+			return true
+		}
+		return false
+	})
+
+	lhm.Put(1, 10)
+	lhm.Put(2, 20)
+	lhm.Get(1)
+	lhm.Put(3, 30)
+	lhm.Put(1, 11)
+	lhm.Put(4, 40)
+
+	assert.Equal(t, []int{2, 3}, evicted)
+	assert.Equal(t, [][]int{{1, 11}, {4, 40}}, testLinkedHashMapEntries(lhm))
+}
+
+func TestLinkedHashMapIteratorPanicsWhenExhausted(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("didn't panic on exhausted iterator")
+		}
+	}()
+	NewLinkedHashMap(InsertionOrder, nil).Iterator().Next()
+}
+
+// a random mix of operations must leave the same keys, in the same order, as a naive ordered slice
+func TestLinkedHashMapMatchesNaiveModel(t *testing.T) {
+	for _, ordering := range []Ordering{InsertionOrder, AccessOrder} {
+		r := rand.New(rand.NewSource(int64(ordering)))
+		lhm := NewLinkedHashMap(ordering, nil)
+		var model []int
+		values := map[int]int{}
+
+		indexOf := func(key int) int {
+			for i, k := range model {
+				if k == key {
+					return i
+				}
+			}
+			return -1
+		}
+		touch := func(key int) {
+			i := indexOf(key)
+			model = append(append(model[:i:i], model[i+1:]...), key)
+		}
+
+		for i := 0; i < 5000; i++ {
+			key := r.Intn(64)
+			switch r.Intn(3) {
+			case 0:
+				if _, ok := values[key]; !ok {
+					model = append(model, key)
+				} else if ordering == AccessOrder {
+					touch(key)
+				}
+				values[key] = i
+				lhm.Put(key, i)
+			case 1:
+				v, ok := lhm.Get(key)
+				ev, eok := values[key]
+				if !eok {
+					ev = -1
+				}
+				assert.Equal(t, []interface{}{ev, eok}, []interface{}{v, ok})
+				if eok && ordering == AccessOrder {
+					touch(key)
+				}
+			case 2:
+				if j := indexOf(key); j != -1 {
+					model = append(model[:j:j], model[j+1:]...)
+					delete(values, key)
+				}
+				lhm.Delete(key)
+			}
+		}
+
+		if model == nil {
+			model = []int{}
+		}
+		assert.Equal(t, model, lhm.Keys())
+	}
+}
@@ -0,0 +1,54 @@
+package history
+
+// Command is a reversible operation. Revert must undo exactly what Apply did so that the two can alternate
+// any number of times.
+type Command interface {
+	Apply() error
+	Revert() error
+}
+
+// Codec turns commands into bytes and back so that a History can be saved and loaded.
+type Codec interface {
+	Encode(cmd Command) ([]byte, error)
+	Decode(data []byte) (Command, error)
+}
+
+// group is a committed transaction, undone and redone as one command.
+type group struct {
+	commands []Command
+}
+
+func (g *group) Apply() error {
+	for i, cmd := range g.commands {
+		if err := cmd.Apply(); err != nil {
+			revert(g.commands[:i])
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *group) Revert() error {
+	for i := len(g.commands) - 1; i >= 0; i-- {
+		if err := g.commands[i].Revert(); err != nil {
+			reapply(g.commands[i+1:])
+			return err
+		}
+	}
+	return nil
+}
+
+//Helper Functions
+// revert and reapply restore a partially applied or reverted group, ignoring errors since the original
+// error is the one reported.
+func revert(commands []Command) {
+	for i := len(commands) - 1; i >= 0; i-- {
+		_ = commands[i].Revert()
+	}
+}
+
+func reapply(commands []Command) {
+	for _, cmd := range commands {
+		_ = cmd.Apply()
+	}
+}
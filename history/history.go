@@ -0,0 +1,269 @@
+package history
+
+import (
+	"encoding/json"
+	"github.com/rewantsoni/go-datastructures/errors"
+	"github.com/rewantsoni/go-datastructures/stack"
+	"io"
+)
+
+// History records applied commands for undo and redo. The stacks hold command ids rather than commands, and
+// the commands themselves live in a map that is pruned whenever an id falls off either stack.
+type History struct {
+	maxDepth int
+	nextID   int
+	commands map[int]Command
+	undo     stack.Stack
+	redo     stack.Stack
+
+	// commands applied since Begin, nil when no transaction is open
+	tx []Command
+}
+
+type snapshot struct {
+	Undo [][][]byte
+	Redo [][][]byte
+}
+
+// NewHistory keeps at most maxDepth undoable commands, forgetting the oldest one beyond that. A
+// non-positive maxDepth keeps everything.
+func NewHistory(maxDepth int) *History {
+	h := &History{
+		maxDepth: maxDepth,
+	}
+	h.reset()
+	return h
+}
+
+func (h *History) Begin() error {
+	if h.tx != nil {
+		return errors.ErrTransactionActive
+	}
+	h.tx = []Command{}
+	return nil
+}
+
+func (h *History) CanRedo() bool {
+	return h.tx == nil && !h.redo.Empty()
+}
+
+func (h *History) CanUndo() bool {
+	return h.tx == nil && !h.undo.Empty()
+}
+
+func (h *History) Clear() {
+	h.tx = nil
+	h.reset()
+}
+
+// Commit records every command applied since Begin as a single undoable step.
+func (h *History) Commit() error {
+	if h.tx == nil {
+		return errors.ErrNoTransaction
+	}
+
+	commands := h.tx
+	h.tx = nil
+	if len(commands) == 0 {
+		return nil
+	}
+	h.record(&group{commands: commands})
+	return nil
+}
+
+// Do applies cmd and, unless it fails, records it and discards everything that could have been redone.
+func (h *History) Do(cmd Command) error {
+	if err := cmd.Apply(); err != nil {
+		return err
+	}
+
+	if h.tx != nil {
+		h.tx = append(h.tx, cmd)
+		return nil
+	}
+	h.record(cmd)
+	return nil
+}
+
+// Load replaces the recorded history with one written by Save. The commands are not applied; the caller is
+// expected to restore the state they were saved against.
+func (h *History) Load(r io.Reader, codec Codec) error {
+	if h.tx != nil {
+		return errors.ErrTransactionActive
+	}
+
+	var s snapshot
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return err
+	}
+
+	undo, err := decodeEntries(s.Undo, codec)
+	if err != nil {
+		return err
+	}
+	redo, err := decodeEntries(s.Redo, codec)
+	if err != nil {
+		return err
+	}
+
+	h.reset()
+	for _, cmd := range undo {
+		h.undo.Push(h.store(cmd))
+	}
+	for _, cmd := range redo {
+		h.redo.Push(h.store(cmd))
+	}
+	return nil
+}
+
+func (h *History) MaxDepth() int {
+	return h.maxDepth
+}
+
+func (h *History) Redo() error {
+	if h.tx != nil {
+		return errors.ErrTransactionActive
+	}
+
+	id, ok := h.redo.TryPeek()
+	if !ok {
+		return errors.ErrNothingToRedo
+	}
+	if err := h.commands[id].Apply(); err != nil {
+		return err
+	}
+
+	h.redo.Pop()
+	h.undo.Push(id)
+	return nil
+}
+
+func (h *History) RedoSize() int {
+	return h.redo.Size()
+}
+
+// Rollback reverts, newest first, every command applied since Begin and records none of them. If a revert
+// fails, the commands already reverted are applied again and the transaction stays open.
+func (h *History) Rollback() error {
+	if h.tx == nil {
+		return errors.ErrNoTransaction
+	}
+
+	for i := len(h.tx) - 1; i >= 0; i-- {
+		if err := h.tx[i].Revert(); err != nil {
+			reapply(h.tx[i+1:])
+			return err
+		}
+	}
+	h.tx = nil
+	return nil
+}
+
+// Save writes the undo and redo stacks, oldest entry first, encoding each command with codec.
+func (h *History) Save(w io.Writer, codec Codec) error {
+	undo, err := h.encodeEntries(h.undo, codec)
+	if err != nil {
+		return err
+	}
+	redo, err := h.encodeEntries(h.redo, codec)
+	if err != nil {
+		return err
+	}
+
+	return json.NewEncoder(w).Encode(snapshot{Undo: undo, Redo: redo})
+}
+
+func (h *History) Undo() error {
+	if h.tx != nil {
+		return errors.ErrTransactionActive
+	}
+
+	id, ok := h.undo.TryPeek()
+	if !ok {
+		return errors.ErrNothingToUndo
+	}
+	if err := h.commands[id].Revert(); err != nil {
+		return err
+	}
+
+	h.undo.Pop()
+	h.redo.Push(id)
+	return nil
+}
+
+func (h *History) UndoSize() int {
+	return h.undo.Size()
+}
+
+//Helper Functions
+func (h *History) reset() {
+	h.commands = map[int]Command{}
+	h.redo = stack.NewArrayStack()
+	if h.maxDepth <= 0 {
+		h.undo = stack.NewArrayStack()
+		return
+	}
+	h.undo = stack.NewBoundedStack(h.maxDepth, stack.DropOldest, h.forget)
+}
+
+func (h *History) record(cmd Command) {
+	for !h.redo.Empty() {
+		h.forget(h.redo.Pop())
+	}
+	h.undo.Push(h.store(cmd))
+}
+
+func (h *History) store(cmd Command) int {
+	id := h.nextID
+	h.nextID++
+	h.commands[id] = cmd
+	return id
+}
+
+func (h *History) forget(id int) {
+	delete(h.commands, id)
+}
+
+// encodeEntries lists the entries of s from the bottom up, a group being encoded as its commands in order.
+func (h *History) encodeEntries(s stack.Stack, codec Codec) ([][][]byte, error) {
+	entries := make([][][]byte, s.Size())
+	i := len(entries) - 1
+	for it := s.Iterator(); it.HasNext(); i-- {
+		cmd := h.commands[it.Next()]
+
+		commands := []Command{cmd}
+		if g, ok := cmd.(*group); ok {
+			commands = g.commands
+		}
+
+		for _, c := range commands {
+			data, err := codec.Encode(c)
+			if err != nil {
+				return nil, err
+			}
+			entries[i] = append(entries[i], data)
+		}
+	}
+	return entries, nil
+}
+
+func decodeEntries(entries [][][]byte, codec Codec) ([]Command, error) {
+	result := make([]Command, 0, len(entries))
+	for _, entry := range entries {
+		commands := make([]Command, 0, len(entry))
+		for _, data := range entry {
+			cmd, err := codec.Decode(data)
+			if err != nil {
+				return nil, err
+			}
+			commands = append(commands, cmd)
+		}
+
+		if len(commands) == 1 {
+			result = append(result, commands[0])
+			continue
+		}
+		result = append(result, &group{commands: commands})
+	}
+	return result, nil
+}
@@ -0,0 +1,272 @@
+package history
+
+import (
+	"bytes"
+	goerrors "errors"
+	"github.com/rewantsoni/go-datastructures/errors"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+var (
+	errTestApply  = goerrors.New("apply failed")
+	errTestRevert = goerrors.New("revert failed")
+)
+
+type testDocument struct {
+	values []int
+}
+
+// testAppend appends value to the document; a negative value fails to apply.
+type testAppend struct {
+	doc        *testDocument
+	value      int
+	failRevert bool
+}
+
+func (ta *testAppend) Apply() error {
+	if ta.value < 0 {
+		return errTestApply
+	}
+	ta.doc.values = append(ta.doc.values, ta.value)
+	return nil
+}
+
+func (ta *testAppend) Revert() error {
+	if ta.failRevert {
+		return errTestRevert
+	}
+	ta.doc.values = ta.doc.values[:len(ta.doc.values)-1]
+	return nil
+}
+
+type testCodec struct {
+	doc *testDocument
+}
+
+func (tc testCodec) Encode(cmd Command) ([]byte, error) {
+	return []byte(strconv.Itoa(cmd.(*testAppend).value)), nil
+}
+
+func (tc testCodec) Decode(data []byte) (Command, error) {
+	value, err := strconv.Atoi(string(data))
+	if err != nil {
+		return nil, err
+	}
+	return &testAppend{doc: tc.doc, value: value}, nil
+}
+
+func TestHistoryUndoRedo(t *testing.T) {
+	testCases := []struct {
+		name           string
+		maxDepth       int
+		actualResult   func(h *History, doc *testDocument) []interface{}
+		expectedResult []interface{}
+	}{
+		{
+			name: "test new history can neither undo nor redo",
+			actualResult: func(h *History, doc *testDocument) []interface{} {
+				return []interface{}{h.CanUndo(), h.CanRedo(), h.Undo(), h.Redo()}
+			},
+			expectedResult: []interface{}{false, false, errors.ErrNothingToUndo, errors.ErrNothingToRedo},
+		},
+		{
+			name: "test undo and redo walk back and forth through commands",
+			actualResult: func(h *History, doc *testDocument) []interface{} {
+				h.Do(&testAppend{doc: doc, value: 1})
+				h.Do(&testAppend{doc: doc, value: 2})
+				h.Undo()
+				h.Undo()
+				h.Redo()
+				return []interface{}{doc.values, h.CanUndo(), h.CanRedo(), h.UndoSize(), h.RedoSize()}
+			},
+			expectedResult: []interface{}{[]int{1}, true, true, 1, 1},
+		},
+		{
+			name: "test a new command invalidates redo",
+			actualResult: func(h *History, doc *testDocument) []interface{} {
+				h.Do(&testAppend{doc: doc, value: 1})
+				h.Do(&testAppend{doc: doc, value: 2})
+				h.Undo()
+				h.Do(&testAppend{doc: doc, value: 3})
+				return []interface{}{doc.values, h.CanRedo(), h.Redo(), len(h.commands)}
+			},
+			expectedResult: []interface{}{[]int{1, 3}, false, errors.ErrNothingToRedo, 2},
+		},
+		{
+			name: "test a failing command is not recorded",
+			actualResult: func(h *History, doc *testDocument) []interface{} {
+				h.Do(&testAppend{doc: doc, value: 1})
+				h.Undo()
+				err := h.Do(&testAppend{doc: doc, value: -1})
+				return []interface{}{err, doc.values, h.UndoSize(), h.CanRedo()}
+			},
+			expectedResult: []interface{}{errTestApply, []int{}, 0, true},
+		},
+		{
+			name:     "test max depth forgets the oldest commands",
+			maxDepth: 2,
+			actualResult: func(h *History, doc *testDocument) []interface{} {
+				for i := 1; i <= 4; i++ {
+					h.Do(&testAppend{doc: doc, value: i})
+				}
+				h.Undo()
+				h.Undo()
+				return []interface{}{h.Undo(), doc.values, h.MaxDepth(), len(h.commands)}
+			},
+			expectedResult: []interface{}{errors.ErrNothingToUndo, []int{1, 2}, 2, 2},
+		},
+		{
+			name: "test clear forgets everything",
+			actualResult: func(h *History, doc *testDocument) []interface{} {
+				h.Do(&testAppend{doc: doc, value: 1})
+				h.Do(&testAppend{doc: doc, value: 2})
+				h.Undo()
+				h.Clear()
+				return []interface{}{h.CanUndo(), h.CanRedo(), doc.values}
+			},
+			expectedResult: []interface{}{false, false, []int{1}},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			doc := &testDocument{values: []int{}}
+			res := testCase.actualResult(NewHistory(testCase.maxDepth), doc)
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func TestHistoryTransactions(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func(h *History, doc *testDocument) []interface{}
+		expectedResult []interface{}
+	}{
+		{
+			name: "test committed transaction is undone and redone as one step",
+			actualResult: func(h *History, doc *testDocument) []interface{} {
+				h.Do(&testAppend{doc: doc, value: 1})
+				h.Begin()
+				h.Do(&testAppend{doc: doc, value: 2})
+				h.Do(&testAppend{doc: doc, value: 3})
+				h.Commit()
+				h.Undo()
+				afterUndo := append([]int{}, doc.values...)
+				h.Redo()
+				return []interface{}{afterUndo, doc.values, h.UndoSize()}
+			},
+			expectedResult: []interface{}{[]int{1}, []int{1, 2, 3}, 2},
+		},
+		{
+			name: "test rolled back transaction reverts its commands and records nothing",
+			actualResult: func(h *History, doc *testDocument) []interface{} {
+				h.Do(&testAppend{doc: doc, value: 1})
+				h.Begin()
+				h.Do(&testAppend{doc: doc, value: 2})
+				h.Do(&testAppend{doc: doc, value: 3})
+				err := h.Rollback()
+				return []interface{}{err, doc.values, h.UndoSize()}
+			},
+			expectedResult: []interface{}{nil, []int{1}, 1},
+		},
+		{
+			name: "test failed rollback reapplies the reverted commands and keeps the transaction open",
+			actualResult: func(h *History, doc *testDocument) []interface{} {
+				h.Do(&testAppend{doc: doc, value: 1})
+				h.Begin()
+				h.Do(&testAppend{doc: doc, value: 2})
+				failing := &testAppend{doc: doc, value: 3, failRevert: true}
+				h.Do(failing)
+				h.Do(&testAppend{doc: doc, value: 4})
+				err := h.Rollback()
+				afterFailure := append([]int{}, doc.values...)
+				failing.failRevert = false
+				return []interface{}{err, afterFailure, h.Begin(), h.Rollback(), doc.values, h.UndoSize()}
+			},
+			expectedResult: []interface{}{errTestRevert, []int{1, 2, 3, 4}, errors.ErrTransactionActive, nil, []int{1}, 1},
+		},
+		{
+			name: "test empty transaction records nothing",
+			actualResult: func(h *History, doc *testDocument) []interface{} {
+				h.Begin()
+				return []interface{}{h.Commit(), h.CanUndo()}
+			},
+			expectedResult: []interface{}{nil, false},
+		},
+		{
+			name: "test undo, redo and nested begin are refused during a transaction",
+			actualResult: func(h *History, doc *testDocument) []interface{} {
+				h.Do(&testAppend{doc: doc, value: 1})
+				h.Begin()
+				return []interface{}{h.CanUndo(), h.Undo(), h.Redo(), h.Begin()}
+			},
+			expectedResult: []interface{}{false, errors.ErrTransactionActive, errors.ErrTransactionActive, errors.ErrTransactionActive},
+		},
+		{
+			name: "test commit and rollback without a transaction",
+			actualResult: func(h *History, doc *testDocument) []interface{} {
+				return []interface{}{h.Commit(), h.Rollback()}
+			},
+			expectedResult: []interface{}{errors.ErrNoTransaction, errors.ErrNoTransaction},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			doc := &testDocument{values: []int{}}
+			res := testCase.actualResult(NewHistory(0), doc)
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func TestGroupApplyFailureLeavesNothingApplied(t *testing.T) {
+	doc := &testDocument{values: []int{}}
+	g := &group{commands: []Command{
+		&testAppend{doc: doc, value: 1},
+		&testAppend{doc: doc, value: 2},
+		&testAppend{doc: doc, value: -1},
+	}}
+
+	assert.Equal(t, errTestApply, g.Apply())
+	assert.Equal(t, []int{}, doc.values)
+}
+
+func TestHistorySaveAndLoad(t *testing.T) {
+	doc := &testDocument{values: []int{}}
+	h := NewHistory(0)
+	h.Do(&testAppend{doc: doc, value: 1})
+	h.Begin()
+	h.Do(&testAppend{doc: doc, value: 2})
+	h.Do(&testAppend{doc: doc, value: 3})
+	h.Commit()
+	h.Do(&testAppend{doc: doc, value: 4})
+	h.Undo()
+
+	var buf bytes.Buffer
+	assert.NoError(t, h.Save(&buf, testCodec{doc: doc}))
+
+	restored := &testDocument{values: []int{1, 2, 3}}
+	loaded := NewHistory(0)
+	assert.NoError(t, loaded.Load(&buf, testCodec{doc: restored}))
+	assert.Equal(t, []int{2, 1}, []int{loaded.UndoSize(), loaded.RedoSize()})
+
+	assert.NoError(t, loaded.Redo())
+	assert.Equal(t, []int{1, 2, 3, 4}, restored.values)
+	assert.NoError(t, loaded.Undo())
+	assert.NoError(t, loaded.Undo())
+	assert.NoError(t, loaded.Undo())
+	assert.Equal(t, []int{}, restored.values)
+	assert.False(t, loaded.CanUndo())
+}
+
+func TestHistoryLoadRejectsInvalidInput(t *testing.T) {
+	doc := &testDocument{values: []int{}}
+	h := NewHistory(0)
+	h.Do(&testAppend{doc: doc, value: 1})
+
+	assert.Error(t, h.Load(bytes.NewBufferString("not json"), testCodec{doc: doc}))
+	assert.Error(t, h.Load(bytes.NewBufferString(`{"Undo":[["bm90IGEgbnVtYmVy"]]}`), testCodec{doc: doc}))
+	assert.True(t, h.CanUndo(), "a failed load must keep the existing history")
+}
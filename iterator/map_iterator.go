@@ -0,0 +1,6 @@
+package iterator
+
+type MapIterator interface {
+	HasNext() bool
+	Next() (key int, value int)
+}
@@ -2,6 +2,7 @@ package list
 
 import (
 	"fmt"
+	"github.com/rewantsoni/go-datastructures/hashmap"
 	"github.com/rewantsoni/go-datastructures/iterator"
 	"github.com/rewantsoni/go-datastructures/operators"
 	"strings"
@@ -295,16 +296,16 @@ func (al *ArrayList) shiftLeft(index int) {
 
 //TODO: Improve the logic for filtering the arrayList
 func (al *ArrayList) filterArrayList(retain bool, elements ...int) {
-	cache := map[int]bool{}
+	cache := hashmap.NewHashMap()
 	temp := make([]int, al.capacity)
 	j := 0
 
 	for _, e := range elements {
-		cache[e] = true
+		cache.Put(e, nought)
 	}
 
 	for i := 0; i < al.Size(); i++ {
-		if cache[al.data[i]] {
+		if cache.ContainsKey(al.data[i]) {
 			if retain {
 				temp[j] = al.data[i]
 				j++
@@ -2,6 +2,7 @@ package list
 
 import (
 	"fmt"
+	"github.com/rewantsoni/go-datastructures/hashmap"
 	"github.com/rewantsoni/go-datastructures/iterator"
 	"github.com/rewantsoni/go-datastructures/operators"
 	"strings"
@@ -25,6 +26,11 @@ type linkedListIterator struct {
 	ll       *LinkedList
 }
 
+type linkedListDescendingIterator struct {
+	currNode *node
+	ll       *LinkedList
+}
+
 func newNode(element int) *node {
 	return &node{
 		data: element,
@@ -96,6 +102,10 @@ func (ll *LinkedList) ContainsAll(elements ...int) bool {
 	return true
 }
 
+func (ll *LinkedList) DescendingIterator() iterator.Iterator {
+	return newLinkedListDescendingIterator(ll)
+}
+
 func (ll *LinkedList) GetAt(index int) int {
 	if ll.IsEmpty() || index < 0 || index >= ll.Size() {
 		panic(fmt.Sprintf("panic: index %d is out of bound length is %d", index, ll.Size()))
@@ -128,6 +138,18 @@ func (ll *LinkedList) LastIndexOf(element int) int {
 	return ll.findLast(element)
 }
 
+func (ll *LinkedList) PeekFirst() int {
+	return ll.GetFirst()
+}
+
+func (ll *LinkedList) PeekLast() int {
+	if ll.IsEmpty() {
+		panic(fmt.Sprintf("panic: index %d is out of bound length is %d", ll.Size()-1, ll.Size()))
+	}
+
+	return ll.last.data
+}
+
 //TODO: make it more readable
 func (ll *LinkedList) Remove(element int) bool {
 
@@ -219,7 +241,7 @@ func (ll *LinkedList) RemoveFirst() int {
 
 //TODO: handle it properly, return painc in removeAt
 func (ll *LinkedList) RemoveLast() int {
-	result, ok := ll.RemoveAt(ll.Size())
+	result, ok := ll.RemoveAt(ll.Size() - 1)
 	if !ok {
 		panic("stack is empty")
 	}
@@ -318,6 +340,19 @@ func (lli *linkedListIterator) Next() int {
 	return temp
 }
 
+func (lldi *linkedListDescendingIterator) HasNext() bool {
+	return lldi.currNode != nil
+}
+
+func (lldi *linkedListDescendingIterator) Next() int {
+	if lldi.currNode == nil {
+		panic("panic: linked list is empty")
+	}
+	temp := lldi.currNode.data
+	lldi.currNode = lldi.currNode.prev
+	return temp
+}
+
 //Helper Functions
 func (ll *LinkedList) addAll(index int, elements ...int) bool {
 	for i, element := range elements {
@@ -403,16 +438,16 @@ func (ll *LinkedList) findLast(element int) int {
 }
 
 func (ll *LinkedList) filterLinkedList(retain bool, elements ...int) {
-	cache := map[int]bool{}
+	cache := hashmap.NewHashMap()
 
 	for _, e := range elements {
-		cache[e] = true
+		cache.Put(e, nought)
 	}
 
 	cur := ll.first
 
 	for cur != nil {
-		if cache[cur.data] {
+		if cache.ContainsKey(cur.data) {
 			if !retain {
 				//can pass node and delete wrt node
 				ll.Remove(cur.data)
@@ -432,3 +467,10 @@ func newLinkedListIterator(ll *LinkedList) *linkedListIterator {
 		ll:       ll,
 	}
 }
+
+func newLinkedListDescendingIterator(ll *LinkedList) *linkedListDescendingIterator {
+	return &linkedListDescendingIterator{
+		currNode: ll.last,
+		ll:       ll,
+	}
+}
\ No newline at end of file
@@ -1029,3 +1029,104 @@ func TestLinkedListClone(t *testing.T) {
 		})
 	}
 }
+
+func TestLinkedListPeekAndRemoveLast(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func() int
+		expectedResult int
+		expectedPanic  bool
+	}{
+		{
+			name: "test peek last on empty linked list",
+			actualResult: func() int {
+				return NewLinkedList().PeekLast()
+			},
+			expectedPanic: true,
+		},
+		{
+			name: "test peek last on linked list with elements",
+			actualResult: func() int {
+				return NewLinkedList(1, 2, 3).PeekLast()
+			},
+			expectedResult: 3,
+		},
+		{
+			name: "test peek first on linked list with elements",
+			actualResult: func() int {
+				return NewLinkedList(1, 2, 3).PeekFirst()
+			},
+			expectedResult: 1,
+		},
+		{
+			name: "test remove last on empty linked list",
+			actualResult: func() int {
+				return NewLinkedList().RemoveLast()
+			},
+			expectedPanic: true,
+		},
+		{
+			name: "test remove last on linked list with elements",
+			actualResult: func() int {
+				ll := NewLinkedList(1, 2, 3)
+				ll.RemoveLast()
+				return ll.RemoveLast()
+			},
+			expectedResult: 2,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				if (r != nil) != testCase.expectedPanic {
+					t.Errorf("paniced when it didn't expect to panic")
+				}
+			}()
+
+			res := testCase.actualResult()
+			if !testCase.expectedPanic {
+				assert.Equal(t, testCase.expectedResult, res)
+			}
+		})
+	}
+}
+
+func TestLinkedListDescendingIterator(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func() []int
+		expectedResult []int
+	}{
+		{
+			name: "test descending iterator on empty linked list",
+			actualResult: func() []int {
+				var res []int
+				it := NewLinkedList().DescendingIterator()
+				for it.HasNext() {
+					res = append(res, it.Next())
+				}
+				return res
+			},
+			expectedResult: nil,
+		},
+		{
+			name: "test descending iterator on linked list with elements",
+			actualResult: func() []int {
+				var res []int
+				it := NewLinkedList(1, 2, 3).DescendingIterator()
+				for it.HasNext() {
+					res = append(res, it.Next())
+				}
+				return res
+			},
+			expectedResult: []int{3, 2, 1},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := testCase.actualResult()
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package operators
+
+type Comparator interface {
+	Compare(a, b int) int
+}
+
+type NaturalOrder struct{}
+
+type ReverseOrder struct{}
+
+func (NaturalOrder) Compare(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (ReverseOrder) Compare(a, b int) int {
+	return NaturalOrder{}.Compare(b, a)
+}
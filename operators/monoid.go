@@ -0,0 +1,36 @@
+package operators
+
+// Monoid combines elements with an associative operation that has an identity element.
+type Monoid interface {
+	Identity() int
+	Combine(a, b int) int
+}
+
+type Sum struct{}
+
+type GCD struct{}
+
+func (Sum) Identity() int {
+	return 0
+}
+
+func (Sum) Combine(a, b int) int {
+	return a + b
+}
+
+func (GCD) Identity() int {
+	return 0
+}
+
+func (GCD) Combine(a, b int) int {
+	if a < 0 {
+		a = -a
+	}
+	if b < 0 {
+		b = -b
+	}
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
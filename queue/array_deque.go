@@ -0,0 +1,192 @@
+package queue
+
+import (
+	"fmt"
+	"github.com/rewantsoni/go-datastructures/iterator"
+	"strings"
+)
+
+type ArrayDeque struct {
+	head int
+	size int
+	data []int
+}
+
+type arrayDequeIterator struct {
+	currentIndex int
+	descending   bool
+	ad           *ArrayDeque
+}
+
+func NewArrayDeque(elements ...int) *ArrayDeque {
+	ad := &ArrayDeque{
+		head: nought,
+		size: nought,
+		data: make([]int, initialCapacity),
+	}
+
+	for _, element := range elements {
+		ad.AddLast(element)
+	}
+
+	return ad
+}
+
+func (ad *ArrayDeque) AddFirst(element int) bool {
+	ad.checkAndIncreaseLimit()
+
+	ad.head = ad.index(-1)
+	ad.data[ad.head] = element
+	ad.size++
+
+	return true
+}
+
+func (ad *ArrayDeque) AddLast(element int) bool {
+	ad.checkAndIncreaseLimit()
+
+	ad.data[ad.index(ad.size)] = element
+	ad.size++
+
+	return true
+}
+
+func (ad *ArrayDeque) Clear() {
+	ad.head = nought
+	ad.size = nought
+	ad.data = make([]int, initialCapacity)
+}
+
+func (ad *ArrayDeque) Dequeue() int {
+	return ad.RemoveFirst()
+}
+
+func (ad *ArrayDeque) DescendingIterator() iterator.Iterator {
+	return newArrayDequeIterator(ad, true)
+}
+
+func (ad *ArrayDeque) Empty() bool {
+	return ad.Size() == 0
+}
+
+func (ad *ArrayDeque) Enqueue(element int) bool {
+	return ad.AddLast(element)
+}
+
+func (ad *ArrayDeque) Iterator() iterator.Iterator {
+	return newArrayDequeIterator(ad, false)
+}
+
+func (ad *ArrayDeque) Peek() int {
+	return ad.PeekFirst()
+}
+
+func (ad *ArrayDeque) PeekFirst() int {
+	return ad.get(0)
+}
+
+func (ad *ArrayDeque) PeekLast() int {
+	return ad.get(ad.Size() - 1)
+}
+
+func (ad *ArrayDeque) RemoveFirst() int {
+	if ad.Empty() {
+		panic("deque is empty")
+	}
+
+	e := ad.data[ad.head]
+	ad.data[ad.head] = 0
+	ad.head = ad.index(1)
+	ad.size--
+
+	ad.checkAndDecreaseLimit()
+	return e
+}
+
+func (ad *ArrayDeque) RemoveLast() int {
+	if ad.Empty() {
+		panic("deque is empty")
+	}
+
+	i := ad.index(ad.size - 1)
+	e := ad.data[i]
+	ad.data[i] = 0
+	ad.size--
+
+	ad.checkAndDecreaseLimit()
+	return e
+}
+
+func (ad *ArrayDeque) Size() int {
+	return ad.size
+}
+
+func (ad *ArrayDeque) String() string {
+	sb := strings.Builder{}
+
+	for i := 0; i < ad.Size(); i++ {
+		sb.WriteString(fmt.Sprintf("%d ", ad.data[ad.index(i)]))
+	}
+
+	return sb.String()
+}
+
+func (adi *arrayDequeIterator) HasNext() bool {
+	return adi.currentIndex < adi.ad.Size()
+}
+
+func (adi *arrayDequeIterator) Next() int {
+	i := adi.currentIndex
+	if adi.descending {
+		i = adi.ad.Size() - 1 - i
+	}
+
+	e := adi.ad.get(i)
+	adi.currentIndex++
+	return e
+}
+
+//Helper Functions
+func (ad *ArrayDeque) checkAndIncreaseLimit() {
+	if ad.size == len(ad.data) {
+		ad.resize(len(ad.data) * scalingFactor)
+	}
+}
+
+func (ad *ArrayDeque) checkAndDecreaseLimit() {
+	if len(ad.data) > initialCapacity && ad.size <= len(ad.data)/shrinkFactor {
+		ad.resize(len(ad.data) / scalingFactor)
+	}
+}
+
+// capacity is always a power of two, so wrapping around is a mask rather than a modulo
+func (ad *ArrayDeque) index(offset int) int {
+	return (ad.head + offset) & (len(ad.data) - 1)
+}
+
+func (ad *ArrayDeque) get(offset int) int {
+	if ad.Empty() || offset < 0 || offset >= ad.Size() {
+		panic(fmt.Sprintf("panic: index %d is out of bound length is %d", offset, ad.Size()))
+	}
+
+	return ad.data[ad.index(offset)]
+}
+
+func (ad *ArrayDeque) resize(capacity int) {
+	temp := make([]int, capacity)
+
+	for i := 0; i < ad.size; i++ {
+		temp[i] = ad.data[ad.index(i)]
+	}
+
+	ad.head = nought
+	ad.data = temp
+}
+
+func newArrayDequeIterator(ad *ArrayDeque, descending bool) *arrayDequeIterator {
+	return &arrayDequeIterator{
+		currentIndex: 0,
+		descending:   descending,
+		ad:           ad,
+	}
+}
@@ -0,0 +1,355 @@
+package queue
+
+import (
+	"github.com/rewantsoni/go-datastructures/iterator"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCreateNewArrayDeque(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func() *ArrayDeque
+		expectedResult func() *ArrayDeque
+	}{
+		{
+			name: "test create new empty array deque",
+			actualResult: func() *ArrayDeque {
+				return NewArrayDeque()
+			},
+			expectedResult: func() *ArrayDeque {
+				return &ArrayDeque{
+					head: 0,
+					size: 0,
+					data: make([]int, initialCapacity),
+				}
+			},
+		},
+		{
+			name: "test create new array deque with elements",
+			actualResult: func() *ArrayDeque {
+				return NewArrayDeque(1, 2, 3)
+			},
+			expectedResult: func() *ArrayDeque {
+				data := make([]int, initialCapacity)
+				data[0], data[1], data[2] = 1, 2, 3
+				return &ArrayDeque{
+					head: 0,
+					size: 3,
+					data: data,
+				}
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := testCase.actualResult()
+			assert.Equal(t, testCase.expectedResult(), res)
+		})
+	}
+}
+
+func TestArrayDequeAddFirstAndAddLast(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func() string
+		expectedResult string
+	}{
+		{
+			name: "test add first on empty array deque",
+			actualResult: func() string {
+				ad := NewArrayDeque()
+				ad.AddFirst(1)
+				return ad.String()
+			},
+			expectedResult: "1 ",
+		},
+		{
+			name: "test add first wraps around the head of the buffer",
+			actualResult: func() string {
+				ad := NewArrayDeque(3, 4)
+				ad.AddFirst(2)
+				ad.AddFirst(1)
+				return ad.String()
+			},
+			expectedResult: "1 2 3 4 ",
+		},
+		{
+			name: "test add last after add first",
+			actualResult: func() string {
+				ad := NewArrayDeque()
+				ad.AddFirst(2)
+				ad.AddLast(3)
+				ad.AddFirst(1)
+				return ad.String()
+			},
+			expectedResult: "1 2 3 ",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := testCase.actualResult()
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func TestArrayDequeResize(t *testing.T) {
+	testCases := []struct {
+		name             string
+		actualResult     func() *ArrayDeque
+		expectedCapacity int
+		expectedString   string
+	}{
+		{
+			name: "test array deque grows when full",
+			actualResult: func() *ArrayDeque {
+				ad := NewArrayDeque()
+				for i := 1; i <= initialCapacity+1; i++ {
+					ad.AddLast(i)
+				}
+				return ad
+			},
+			expectedCapacity: initialCapacity * scalingFactor,
+			expectedString:   "1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 ",
+		},
+		{
+			name: "test array deque grows while wrapped around",
+			actualResult: func() *ArrayDeque {
+				ad := NewArrayDeque()
+				for i := 9; i <= initialCapacity; i++ {
+					ad.AddLast(i)
+				}
+				for i := 8; i >= 0; i-- {
+					ad.AddFirst(i)
+				}
+				return ad
+			},
+			expectedCapacity: initialCapacity * scalingFactor,
+			expectedString:   "0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 ",
+		},
+		{
+			name: "test array deque shrinks when sparse",
+			actualResult: func() *ArrayDeque {
+				ad := NewArrayDeque()
+				for i := 1; i <= 4*initialCapacity; i++ {
+					ad.AddLast(i)
+				}
+				for i := 1; i <= 4*initialCapacity-2; i++ {
+					ad.RemoveFirst()
+				}
+				return ad
+			},
+			expectedCapacity: initialCapacity,
+			expectedString:   "63 64 ",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := testCase.actualResult()
+			assert.Equal(t, testCase.expectedCapacity, len(res.data))
+			assert.Equal(t, testCase.expectedString, res.String())
+		})
+	}
+}
+
+func TestArrayDequeRemove(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func() int
+		expectedResult int
+		expectedPanic  bool
+	}{
+		{
+			name: "test remove first on empty array deque",
+			actualResult: func() int {
+				return NewArrayDeque().RemoveFirst()
+			},
+			expectedPanic: true,
+		},
+		{
+			name: "test remove last on empty array deque",
+			actualResult: func() int {
+				return NewArrayDeque().RemoveLast()
+			},
+			expectedPanic: true,
+		},
+		{
+			name: "test remove first on array deque",
+			actualResult: func() int {
+				return NewArrayDeque(1, 2, 3).RemoveFirst()
+			},
+			expectedResult: 1,
+		},
+		{
+			name: "test remove last on array deque",
+			actualResult: func() int {
+				return NewArrayDeque(1, 2, 3).RemoveLast()
+			},
+			expectedResult: 3,
+		},
+		{
+			name: "test remove last on wrapped around array deque",
+			actualResult: func() int {
+				ad := NewArrayDeque()
+				ad.AddFirst(1)
+				return ad.RemoveLast()
+			},
+			expectedResult: 1,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				if (r != nil) != testCase.expectedPanic {
+					t.Errorf("Remove() paniced when it didn't expect to panic")
+				}
+			}()
+
+			res := testCase.actualResult()
+			if !testCase.expectedPanic {
+				assert.Equal(t, testCase.expectedResult, res)
+			}
+		})
+	}
+}
+
+func TestArrayDequePeek(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func() int
+		expectedResult int
+		expectedPanic  bool
+	}{
+		{
+			name: "test peek first on empty array deque",
+			actualResult: func() int {
+				return NewArrayDeque().PeekFirst()
+			},
+			expectedPanic: true,
+		},
+		{
+			name: "test peek last on empty array deque",
+			actualResult: func() int {
+				return NewArrayDeque().PeekLast()
+			},
+			expectedPanic: true,
+		},
+		{
+			name: "test peek first on array deque",
+			actualResult: func() int {
+				return NewArrayDeque(1, 2, 3).PeekFirst()
+			},
+			expectedResult: 1,
+		},
+		{
+			name: "test peek last on array deque",
+			actualResult: func() int {
+				return NewArrayDeque(1, 2, 3).PeekLast()
+			},
+			expectedResult: 3,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				if (r != nil) != testCase.expectedPanic {
+					t.Errorf("Peek() paniced when it didn't expect to panic")
+				}
+			}()
+
+			res := testCase.actualResult()
+			if !testCase.expectedPanic {
+				assert.Equal(t, testCase.expectedResult, res)
+			}
+		})
+	}
+}
+
+func TestArrayDequeIterator(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func() []int
+		expectedResult []int
+	}{
+		{
+			name: "test iterator on empty array deque",
+			actualResult: func() []int {
+				return testCollect(NewArrayDeque().Iterator())
+			},
+			expectedResult: nil,
+		},
+		{
+			name: "test iterator on wrapped around array deque",
+			actualResult: func() []int {
+				ad := NewArrayDeque(3, 4)
+				ad.AddFirst(2)
+				ad.AddFirst(1)
+				return testCollect(ad.Iterator())
+			},
+			expectedResult: []int{1, 2, 3, 4},
+		},
+		{
+			name: "test descending iterator on wrapped around array deque",
+			actualResult: func() []int {
+				ad := NewArrayDeque(3, 4)
+				ad.AddFirst(2)
+				ad.AddFirst(1)
+				return testCollect(ad.DescendingIterator())
+			},
+			expectedResult: []int{4, 3, 2, 1},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := testCase.actualResult()
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func TestArrayDequeAsQueue(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func() []int
+		expectedResult []int
+	}{
+		{
+			name: "test array deque dequeues in insertion order",
+			actualResult: func() []int {
+				var q Queue = NewArrayDeque()
+				q.Enqueue(1)
+				q.Enqueue(2)
+				q.Enqueue(3)
+				return []int{q.Dequeue(), q.Peek(), q.Size()}
+			},
+			expectedResult: []int{1, 2, 2},
+		},
+		{
+			name: "test array deque clear",
+			actualResult: func() []int {
+				var q Queue = NewArrayDeque(1, 2, 3)
+				q.Clear()
+				q.Enqueue(4)
+				return []int{q.Peek(), q.Size()}
+			},
+			expectedResult: []int{4, 1},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := testCase.actualResult()
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func testCollect(it iterator.Iterator) []int {
+	var res []int
+	for it.HasNext() {
+		res = append(res, it.Next())
+	}
+	return res
+}
@@ -0,0 +1,215 @@
+package queue
+
+import (
+	"context"
+	"github.com/rewantsoni/go-datastructures/errors"
+	"github.com/rewantsoni/go-datastructures/list"
+	"sync"
+	"time"
+)
+
+type BlockingQueue struct {
+	mu       sync.Mutex
+	capacity int
+	closed   bool
+	items    *ArrayDeque
+
+	// closed and replaced on every state change so that waiters can select on them alongside ctx.Done()
+	notEmpty chan struct{}
+	notFull  chan struct{}
+	// goroutines parked in Put or Take, so tests can wait for a waiter before waking it
+	waiting int
+}
+
+func NewBlockingQueue(capacity int) *BlockingQueue {
+	if capacity <= 0 {
+		return nil
+	}
+
+	return &BlockingQueue{
+		capacity: capacity,
+		items:    NewArrayDeque(),
+		notEmpty: make(chan struct{}),
+		notFull:  make(chan struct{}),
+	}
+}
+
+func (bq *BlockingQueue) Capacity() int {
+	return bq.capacity
+}
+
+func (bq *BlockingQueue) Clear() {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	bq.items.Clear()
+	bq.signalNotFull()
+}
+
+func (bq *BlockingQueue) Close() {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	if bq.closed {
+		return
+	}
+
+	bq.closed = true
+	bq.signalNotEmpty()
+	bq.signalNotFull()
+}
+
+func (bq *BlockingQueue) Dequeue() int {
+	e, ok := bq.Poll(0)
+	if !ok {
+		panic("queue is empty")
+	}
+	return e
+}
+
+func (bq *BlockingQueue) DrainTo(l list.List, max int) int {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	n := 0
+	for !bq.items.Empty() && (max <= 0 || n < max) {
+		l.Add(bq.items.RemoveFirst())
+		n++
+	}
+
+	if n > 0 {
+		bq.signalNotFull()
+	}
+	return n
+}
+
+func (bq *BlockingQueue) Empty() bool {
+	return bq.Size() == 0
+}
+
+func (bq *BlockingQueue) Enqueue(element int) bool {
+	return bq.Offer(element, 0)
+}
+
+func (bq *BlockingQueue) IsClosed() bool {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	return bq.closed
+}
+
+func (bq *BlockingQueue) Offer(element int, timeout time.Duration) bool {
+	ctx, cancel := timeoutContext(timeout)
+	defer cancel()
+
+	return bq.Put(ctx, element) == nil
+}
+
+func (bq *BlockingQueue) Peek() int {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	if bq.items.Empty() {
+		panic("queue is empty")
+	}
+	return bq.items.PeekFirst()
+}
+
+func (bq *BlockingQueue) Poll(timeout time.Duration) (int, bool) {
+	ctx, cancel := timeoutContext(timeout)
+	defer cancel()
+
+	e, err := bq.Take(ctx)
+	return e, err == nil
+}
+
+func (bq *BlockingQueue) Put(ctx context.Context, element int) error {
+	for {
+		bq.mu.Lock()
+		if bq.closed {
+			bq.mu.Unlock()
+			return errors.ErrQueueClosed
+		}
+
+		if bq.items.Size() < bq.capacity {
+			bq.items.AddLast(element)
+			bq.signalNotEmpty()
+			bq.mu.Unlock()
+			return nil
+		}
+
+		if err := bq.park(ctx, bq.notFull); err != nil {
+			return err
+		}
+	}
+}
+
+func (bq *BlockingQueue) Remaining() int {
+	return bq.capacity - bq.Size()
+}
+
+func (bq *BlockingQueue) Size() int {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	return bq.items.Size()
+}
+
+func (bq *BlockingQueue) Take(ctx context.Context) (int, error) {
+	for {
+		bq.mu.Lock()
+		if !bq.items.Empty() {
+			e := bq.items.RemoveFirst()
+			bq.signalNotFull()
+			bq.mu.Unlock()
+			return e, nil
+		}
+
+		if bq.closed {
+			bq.mu.Unlock()
+			return -1, errors.ErrQueueClosed
+		}
+
+		if err := bq.park(ctx, bq.notEmpty); err != nil {
+			return -1, err
+		}
+	}
+}
+
+//Helper Functions
+// park is called with mu held and releases it, then waits until wait is closed or ctx is done.
+func (bq *BlockingQueue) park(ctx context.Context, wait chan struct{}) error {
+	bq.waiting++
+	bq.mu.Unlock()
+
+	var err error
+	select {
+	case <-wait:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	bq.mu.Lock()
+	bq.waiting--
+	bq.mu.Unlock()
+	return err
+}
+
+func (bq *BlockingQueue) signalNotEmpty() {
+	close(bq.notEmpty)
+	bq.notEmpty = make(chan struct{})
+}
+
+func (bq *BlockingQueue) signalNotFull() {
+	close(bq.notFull)
+	bq.notFull = make(chan struct{})
+}
+
+func timeoutContext(timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		return ctx, cancel
+	}
+	return context.WithTimeout(context.Background(), timeout)
+}
@@ -0,0 +1,385 @@
+package queue
+
+import (
+	"context"
+	"github.com/rewantsoni/go-datastructures/errors"
+	"github.com/rewantsoni/go-datastructures/list"
+	"github.com/stretchr/testify/assert"
+	"runtime"
+	"testing"
+	"time"
+)
+
+const testWaitTimeout = 5 * time.Second
+
+func TestCreateNewBlockingQueue(t *testing.T) {
+	testCases := []struct {
+		name             string
+		capacity         int
+		expectedNil      bool
+		expectedCapacity int
+	}{
+		{
+			name:        "test create blocking queue with zero capacity",
+			capacity:    0,
+			expectedNil: true,
+		},
+		{
+			name:        "test create blocking queue with negative capacity",
+			capacity:    -1,
+			expectedNil: true,
+		},
+		{
+			name:             "test create blocking queue with capacity",
+			capacity:         4,
+			expectedCapacity: 4,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := NewBlockingQueue(testCase.capacity)
+			if testCase.expectedNil {
+				assert.Nil(t, res)
+				return
+			}
+			assert.Equal(t, testCase.expectedCapacity, res.Capacity())
+			assert.Equal(t, testCase.expectedCapacity, res.Remaining())
+			assert.True(t, res.Empty())
+		})
+	}
+}
+
+func TestBlockingQueueNonBlocking(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func() []interface{}
+		expectedResult []interface{}
+	}{
+		{
+			name: "test enqueue on full blocking queue",
+			actualResult: func() []interface{} {
+				bq := NewBlockingQueue(2)
+				return []interface{}{bq.Enqueue(1), bq.Enqueue(2), bq.Enqueue(3), bq.Size()}
+			},
+			expectedResult: []interface{}{true, true, false, 2},
+		},
+		{
+			name: "test offer without timeout on full blocking queue",
+			actualResult: func() []interface{} {
+				bq := NewBlockingQueue(1)
+				return []interface{}{bq.Offer(1, 0), bq.Offer(2, 0), bq.Offer(3, time.Millisecond)}
+			},
+			expectedResult: []interface{}{true, false, false},
+		},
+		{
+			name: "test poll without timeout on empty blocking queue",
+			actualResult: func() []interface{} {
+				bq := NewBlockingQueue(1)
+				e, ok := bq.Poll(0)
+				return []interface{}{e, ok}
+			},
+			expectedResult: []interface{}{-1, false},
+		},
+		{
+			name: "test poll with timeout on empty blocking queue",
+			actualResult: func() []interface{} {
+				bq := NewBlockingQueue(1)
+				e, ok := bq.Poll(time.Millisecond)
+				return []interface{}{e, ok}
+			},
+			expectedResult: []interface{}{-1, false},
+		},
+		{
+			name: "test dequeue and peek keep fifo order",
+			actualResult: func() []interface{} {
+				bq := NewBlockingQueue(3)
+				bq.Enqueue(1)
+				bq.Enqueue(2)
+				return []interface{}{bq.Peek(), bq.Dequeue(), bq.Dequeue(), bq.Remaining()}
+			},
+			expectedResult: []interface{}{1, 1, 2, 3},
+		},
+		{
+			name: "test clear frees capacity",
+			actualResult: func() []interface{} {
+				bq := NewBlockingQueue(1)
+				bq.Enqueue(1)
+				bq.Clear()
+				return []interface{}{bq.Empty(), bq.Enqueue(2)}
+			},
+			expectedResult: []interface{}{true, true},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := testCase.actualResult()
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func TestBlockingQueueDequeueOnEmpty(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Dequeue() didn't panic on empty blocking queue")
+		}
+	}()
+
+	NewBlockingQueue(1).Dequeue()
+}
+
+func TestBlockingQueuePutBlocksUntilTake(t *testing.T) {
+	bq := NewBlockingQueue(1)
+	assert.NoError(t, bq.Put(context.Background(), 1))
+
+	done := make(chan error)
+	go func() {
+		done <- bq.Put(context.Background(), 2)
+	}()
+	testAwaitWaiting(t, bq, 1)
+
+	select {
+	case <-done:
+		t.Fatal("Put() returned while the queue was full")
+	default:
+	}
+
+	e, err := bq.Take(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, e)
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(testWaitTimeout):
+		t.Fatal("Put() was not woken up by Take()")
+	}
+
+	e, err = bq.Take(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, e)
+}
+
+func TestBlockingQueueTakeBlocksUntilPut(t *testing.T) {
+	bq := NewBlockingQueue(1)
+
+	done := make(chan int)
+	go func() {
+		e, _ := bq.Take(context.Background())
+		done <- e
+	}()
+	testAwaitWaiting(t, bq, 1)
+
+	select {
+	case <-done:
+		t.Fatal("Take() returned while the queue was empty")
+	default:
+	}
+
+	assert.NoError(t, bq.Put(context.Background(), 7))
+
+	select {
+	case e := <-done:
+		assert.Equal(t, 7, e)
+	case <-time.After(testWaitTimeout):
+		t.Fatal("Take() was not woken up by Put()")
+	}
+}
+
+func TestBlockingQueueContextCancellation(t *testing.T) {
+	testCases := []struct {
+		name          string
+		actualResult  func(ctx context.Context) error
+		expectedError error
+	}{
+		{
+			name: "test put on full blocking queue with cancelled context",
+			actualResult: func(ctx context.Context) error {
+				bq := NewBlockingQueue(1)
+				bq.Enqueue(1)
+				return bq.Put(ctx, 2)
+			},
+			expectedError: context.Canceled,
+		},
+		{
+			name: "test take on empty blocking queue with cancelled context",
+			actualResult: func(ctx context.Context) error {
+				_, err := NewBlockingQueue(1).Take(ctx)
+				return err
+			},
+			expectedError: context.Canceled,
+		},
+		{
+			name: "test put with cancelled context succeeds when there is space",
+			actualResult: func(ctx context.Context) error {
+				return NewBlockingQueue(1).Put(ctx, 1)
+			},
+			expectedError: nil,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			assert.Equal(t, testCase.expectedError, testCase.actualResult(ctx))
+		})
+	}
+}
+
+func TestBlockingQueueCloseWakesWaiters(t *testing.T) {
+	empty := NewBlockingQueue(1)
+	full := NewBlockingQueue(1)
+	full.Enqueue(1)
+
+	takeErr := make(chan error)
+	putErr := make(chan error)
+	go func() {
+		_, err := empty.Take(context.Background())
+		takeErr <- err
+	}()
+	go func() {
+		putErr <- full.Put(context.Background(), 2)
+	}()
+	testAwaitWaiting(t, empty, 1)
+	testAwaitWaiting(t, full, 1)
+
+	empty.Close()
+	full.Close()
+
+	for _, ch := range []chan error{takeErr, putErr} {
+		select {
+		case err := <-ch:
+			assert.Equal(t, errors.ErrQueueClosed, err)
+		case <-time.After(testWaitTimeout):
+			t.Fatal("Close() did not wake up a waiter")
+		}
+	}
+}
+
+func TestBlockingQueueCloseDrainsRemaining(t *testing.T) {
+	bq := NewBlockingQueue(2)
+	bq.Enqueue(1)
+	bq.Close()
+	bq.Close()
+
+	assert.True(t, bq.IsClosed())
+	assert.False(t, bq.Enqueue(2))
+	assert.Equal(t, errors.ErrQueueClosed, bq.Put(context.Background(), 2))
+
+	e, err := bq.Take(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, e)
+
+	_, err = bq.Take(context.Background())
+	assert.Equal(t, errors.ErrQueueClosed, err)
+}
+
+func TestBlockingQueueDrainTo(t *testing.T) {
+	testCases := []struct {
+		name          string
+		max           int
+		expectedCount int
+		expectedList  list.List
+		expectedSize  int
+	}{
+		{
+			name:          "test drain to with max less than size",
+			max:           2,
+			expectedCount: 2,
+			expectedList:  list.NewArrayList(1, 2),
+			expectedSize:  1,
+		},
+		{
+			name:          "test drain to with max greater than size",
+			max:           5,
+			expectedCount: 3,
+			expectedList:  list.NewArrayList(1, 2, 3),
+			expectedSize:  0,
+		},
+		{
+			name:          "test drain to without max",
+			max:           0,
+			expectedCount: 3,
+			expectedList:  list.NewArrayList(1, 2, 3),
+			expectedSize:  0,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			bq := NewBlockingQueue(3)
+			bq.Enqueue(1)
+			bq.Enqueue(2)
+			bq.Enqueue(3)
+
+			l := list.NewArrayList()
+			assert.Equal(t, testCase.expectedCount, bq.DrainTo(l, testCase.max))
+			assert.Equal(t, testCase.expectedList, l)
+			assert.Equal(t, testCase.expectedSize, bq.Size())
+		})
+	}
+}
+
+func TestBlockingQueueDrainToWakesProducer(t *testing.T) {
+	bq := NewBlockingQueue(1)
+	bq.Enqueue(1)
+
+	done := make(chan error)
+	go func() {
+		done <- bq.Put(context.Background(), 2)
+	}()
+	testAwaitWaiting(t, bq, 1)
+
+	bq.DrainTo(list.NewArrayList(), 0)
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(testWaitTimeout):
+		t.Fatal("DrainTo() did not wake up a producer")
+	}
+}
+
+func TestBlockingQueueProducerConsumer(t *testing.T) {
+	bq := NewBlockingQueue(4)
+	const n = 1000
+
+	go func() {
+		for i := 0; i < n; i++ {
+			_ = bq.Put(context.Background(), i)
+		}
+		bq.Close()
+	}()
+
+	var res []int
+	for {
+		e, err := bq.Take(context.Background())
+		if err != nil {
+			assert.Equal(t, errors.ErrQueueClosed, err)
+			break
+		}
+		res = append(res, e)
+	}
+
+	assert.Len(t, res, n)
+	for i, e := range res {
+		assert.Equal(t, i, e)
+	}
+}
+
+// testAwaitWaiting waits until n goroutines are parked in Put or Take on bq.
+func testAwaitWaiting(t *testing.T, bq *BlockingQueue, n int) {
+	deadline := time.Now().Add(testWaitTimeout)
+	for {
+		bq.mu.Lock()
+		waiting := bq.waiting
+		bq.mu.Unlock()
+
+		if waiting >= n {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d goroutines are parked on the blocking queue, expected %d", waiting, n)
+		}
+		runtime.Gosched()
+	}
+}
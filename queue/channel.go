@@ -0,0 +1,112 @@
+package queue
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// UnboundedChan is a channel whose sends never block: whatever the receiver has not yet taken is buffered
+// in a LinkedListQueue. Closing In() lets Out() drain and then close; cancelling the context closes Out()
+// straight away and drops anything still buffered.
+type UnboundedChan struct {
+	in     chan int
+	out    chan int
+	buffer Queue
+	size   int64
+}
+
+// ToChannel sends the elements of q on the returned channel in queue order, dequeuing each one only once a
+// receiver has taken it, and closes the channel when q is empty or ctx is done. The caller must not touch q
+// until the channel is closed.
+func ToChannel(ctx context.Context, q Queue) <-chan int {
+	ch := make(chan int)
+
+	go func() {
+		defer close(ch)
+
+		for !q.Empty() {
+			select {
+			case ch <- q.Peek():
+				q.Dequeue()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// FromChannel enqueues everything received on ch until it is closed or ctx is done, and returns the number
+// of elements added along with ctx.Err() if it stopped early.
+func FromChannel(ctx context.Context, ch <-chan int, q Queue) (int, error) {
+	n := 0
+	for {
+		select {
+		case e, ok := <-ch:
+			if !ok {
+				return n, nil
+			}
+			if q.Enqueue(e) {
+				n++
+			}
+		case <-ctx.Done():
+			return n, ctx.Err()
+		}
+	}
+}
+
+func NewUnboundedChan(ctx context.Context) *UnboundedChan {
+	uc := &UnboundedChan{
+		in:     make(chan int),
+		out:    make(chan int),
+		buffer: NewLinkedListQueue(),
+	}
+
+	go uc.run(ctx)
+	return uc
+}
+
+func (uc *UnboundedChan) In() chan<- int {
+	return uc.in
+}
+
+// Len is the number of elements buffered between In() and Out(); it may briefly lag a send that just completed.
+func (uc *UnboundedChan) Len() int {
+	return int(atomic.LoadInt64(&uc.size))
+}
+
+func (uc *UnboundedChan) Out() <-chan int {
+	return uc.out
+}
+
+//Helper Functions
+func (uc *UnboundedChan) run(ctx context.Context) {
+	defer close(uc.out)
+
+	in := uc.in
+	for in != nil || !uc.buffer.Empty() {
+		// a nil channel blocks forever, which disables the send case while there is nothing to send
+		var out chan int
+		var next int
+		if !uc.buffer.Empty() {
+			out = uc.out
+			next = uc.buffer.Peek()
+		}
+
+		select {
+		case e, ok := <-in:
+			if !ok {
+				in = nil
+				continue
+			}
+			uc.buffer.Enqueue(e)
+			atomic.AddInt64(&uc.size, 1)
+		case out <- next:
+			uc.buffer.Dequeue()
+			atomic.AddInt64(&uc.size, -1)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
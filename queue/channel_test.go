@@ -0,0 +1,161 @@
+package queue
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestToChannel(t *testing.T) {
+	testCases := []struct {
+		name           string
+		elements       []int
+		expectedResult []int
+	}{
+		{
+			name:           "test to channel on empty queue",
+			elements:       nil,
+			expectedResult: nil,
+		},
+		{
+			name:           "test to channel keeps queue order",
+			elements:       []int{1, 2, 3},
+			expectedResult: []int{1, 2, 3},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			before := runtime.NumGoroutine()
+
+			q := NewLinkedListQueue()
+			for _, e := range testCase.elements {
+				q.Enqueue(e)
+			}
+
+			var res []int
+			for e := range ToChannel(context.Background(), q) {
+				res = append(res, e)
+			}
+
+			assert.Equal(t, testCase.expectedResult, res)
+			assert.True(t, q.Empty())
+			testNoGoroutineLeak(t, before)
+		})
+	}
+}
+
+func TestToChannelBackpressureAndCancellation(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	q := NewArrayDeque(1, 2, 3)
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := ToChannel(ctx, q)
+
+	assert.Equal(t, 1, <-ch)
+	cancel()
+
+	_, ok := <-ch
+	for ok {
+		_, ok = <-ch
+	}
+
+	// only what the receiver actually took has left the queue
+	assert.GreaterOrEqual(t, q.Size(), 1)
+	assert.LessOrEqual(t, q.Size(), 2)
+	assert.Equal(t, 3, q.PeekLast())
+	testNoGoroutineLeak(t, before)
+}
+
+func TestFromChannel(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+
+	q := NewLinkedListQueue()
+	n, err := FromChannel(context.Background(), ch, q)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, 1, q.Peek())
+	assert.Equal(t, 3, q.Size())
+}
+
+func TestFromChannelCancelled(t *testing.T) {
+	ch := make(chan int)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	n, err := FromChannel(ctx, ch, NewLinkedListQueue())
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 0, n)
+}
+
+func TestFromChannelRespectsBoundedQueue(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+
+	bq := NewBlockingQueue(2)
+	n, err := FromChannel(context.Background(), ch, bq)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+}
+
+func TestUnboundedChan(t *testing.T) {
+	before := runtime.NumGoroutine()
+	uc := NewUnboundedChan(context.Background())
+
+	const n = 1000
+	for i := 0; i < n; i++ {
+		select {
+		case uc.In() <- i:
+		case <-time.After(testWaitTimeout):
+			t.Fatal("send on unbounded channel blocked")
+		}
+	}
+	deadline := time.Now().Add(testWaitTimeout)
+	for uc.Len() != n && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	assert.Equal(t, n, uc.Len())
+	close(uc.In())
+
+	expected := 0
+	for e := range uc.Out() {
+		assert.Equal(t, expected, e)
+		expected++
+	}
+	assert.Equal(t, n, expected)
+	assert.Equal(t, 0, uc.Len())
+	testNoGoroutineLeak(t, before)
+}
+
+func TestUnboundedChanCancelled(t *testing.T) {
+	before := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+	uc := NewUnboundedChan(ctx)
+
+	uc.In() <- 1
+	uc.In() <- 2
+	assert.Equal(t, 1, <-uc.Out())
+	cancel()
+
+	for range uc.Out() {
+	}
+	testNoGoroutineLeak(t, before)
+}
+
+func testNoGoroutineLeak(t *testing.T, before int) {
+	deadline := time.Now().Add(testWaitTimeout)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			t.Fatalf("goroutine leak: %d goroutines running, expected at most %d", runtime.NumGoroutine(), before)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
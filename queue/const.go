@@ -0,0 +1,8 @@
+package queue
+
+const (
+	initialCapacity = 16
+	scalingFactor   = 2
+	shrinkFactor    = 4
+	nought          = 0
+)
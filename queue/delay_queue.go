@@ -0,0 +1,182 @@
+package queue
+
+import (
+	"container/heap"
+	"context"
+	"github.com/rewantsoni/go-datastructures/clock"
+	"sync"
+	"time"
+)
+
+// DelayQueue holds each element until its ready-at time has passed; elements that are due leave the queue
+// in ready-at order, and elements with the same ready-at time in insertion order.
+type DelayQueue struct {
+	mu    sync.Mutex
+	clock clock.Clock
+	items delayItems
+	seq   uint64
+
+	// closed and replaced whenever an element is added so that Take can re-evaluate what it is waiting for
+	added chan struct{}
+}
+
+type delayItem struct {
+	element int
+	readyAt time.Time
+	seq     uint64
+}
+
+type delayItems []delayItem
+
+func NewDelayQueue(c clock.Clock) *DelayQueue {
+	if c == nil {
+		c = clock.New()
+	}
+
+	return &DelayQueue{
+		clock: c,
+		added: make(chan struct{}),
+	}
+}
+
+func (dq *DelayQueue) Add(element int, delay time.Duration) bool {
+	return dq.AddAt(element, dq.clock.Now().Add(delay))
+}
+
+func (dq *DelayQueue) AddAt(element int, readyAt time.Time) bool {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+
+	heap.Push(&dq.items, delayItem{element: element, readyAt: readyAt, seq: dq.seq})
+	dq.seq++
+
+	close(dq.added)
+	dq.added = make(chan struct{})
+	return true
+}
+
+func (dq *DelayQueue) Clear() {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+
+	dq.items = nil
+}
+
+// Dequeue removes the earliest element if it is due, and panics otherwise.
+func (dq *DelayQueue) Dequeue() int {
+	e, ok := dq.Poll()
+	if !ok {
+		panic("queue has no expired element")
+	}
+	return e
+}
+
+func (dq *DelayQueue) Empty() bool {
+	return dq.Size() == 0
+}
+
+func (dq *DelayQueue) Enqueue(element int) bool {
+	return dq.Add(element, 0)
+}
+
+// Peek returns the element with the earliest ready-at time, whether or not it is due yet.
+func (dq *DelayQueue) Peek() int {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+
+	if len(dq.items) == 0 {
+		panic("queue is empty")
+	}
+	return dq.items[0].element
+}
+
+func (dq *DelayQueue) Poll() (int, bool) {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+
+	e, ok, _ := dq.pollLocked()
+	return e, ok
+}
+
+func (dq *DelayQueue) Size() int {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+
+	return len(dq.items)
+}
+
+func (dq *DelayQueue) Take(ctx context.Context) (int, error) {
+	for {
+		dq.mu.Lock()
+		e, ok, wait := dq.pollLocked()
+		added := dq.added
+		dq.mu.Unlock()
+
+		if ok {
+			return e, nil
+		}
+
+		var timer clock.Timer
+		var expired <-chan time.Time
+		if wait > 0 {
+			timer = dq.clock.NewTimer(wait)
+			expired = timer.C()
+		}
+
+		select {
+		case <-expired:
+		case <-added:
+		case <-ctx.Done():
+		}
+
+		if timer != nil {
+			timer.Stop()
+		}
+		if ctx.Err() != nil {
+			return -1, ctx.Err()
+		}
+	}
+}
+
+func (di delayItems) Len() int {
+	return len(di)
+}
+
+func (di delayItems) Less(i, j int) bool {
+	if di[i].readyAt.Equal(di[j].readyAt) {
+		return di[i].seq < di[j].seq
+	}
+	return di[i].readyAt.Before(di[j].readyAt)
+}
+
+func (di delayItems) Swap(i, j int) {
+	di[i], di[j] = di[j], di[i]
+}
+
+func (di *delayItems) Push(x interface{}) {
+	*di = append(*di, x.(delayItem))
+}
+
+func (di *delayItems) Pop() interface{} {
+	old := *di
+	item := old[len(old)-1]
+	*di = old[:len(old)-1]
+	return item
+}
+
+//Helper Functions
+
+// pollLocked removes the head if it is due; otherwise it reports how long until it will be, or zero if the
+// queue is empty.
+func (dq *DelayQueue) pollLocked() (int, bool, time.Duration) {
+	if len(dq.items) == 0 {
+		return -1, false, 0
+	}
+
+	wait := dq.items[0].readyAt.Sub(dq.clock.Now())
+	if wait > 0 {
+		return -1, false, wait
+	}
+
+	return heap.Pop(&dq.items).(delayItem).element, true, 0
+}
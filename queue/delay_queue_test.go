@@ -0,0 +1,234 @@
+package queue
+
+import (
+	"context"
+	"github.com/rewantsoni/go-datastructures/clock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var testEpoch = time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func TestDelayQueuePoll(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func(dq *DelayQueue, fc *clock.FakeClock) []int
+		expectedResult []int
+	}{
+		{
+			name: "test poll on empty delay queue",
+			actualResult: func(dq *DelayQueue, fc *clock.FakeClock) []int {
+				return testPollAll(dq)
+			},
+			expectedResult: nil,
+		},
+		{
+			name: "test poll before any element is due",
+			actualResult: func(dq *DelayQueue, fc *clock.FakeClock) []int {
+				dq.Add(1, time.Second)
+				return testPollAll(dq)
+			},
+			expectedResult: nil,
+		},
+		{
+			name: "test poll returns only expired elements in ready order",
+			actualResult: func(dq *DelayQueue, fc *clock.FakeClock) []int {
+				dq.Add(3, 3*time.Second)
+				dq.Add(1, time.Second)
+				dq.Add(2, 2*time.Second)
+				fc.Advance(2 * time.Second)
+				return testPollAll(dq)
+			},
+			expectedResult: []int{1, 2},
+		},
+		{
+			name: "test poll keeps insertion order for equal ready times",
+			actualResult: func(dq *DelayQueue, fc *clock.FakeClock) []int {
+				at := testEpoch.Add(time.Second)
+				dq.AddAt(5, at)
+				dq.AddAt(4, at)
+				dq.AddAt(6, at)
+				fc.Set(at)
+				return testPollAll(dq)
+			},
+			expectedResult: []int{5, 4, 6},
+		},
+		{
+			name: "test enqueue is ready immediately",
+			actualResult: func(dq *DelayQueue, fc *clock.FakeClock) []int {
+				dq.Enqueue(1)
+				return []int{dq.Dequeue()}
+			},
+			expectedResult: []int{1},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			fc := clock.NewFakeClock(testEpoch)
+			res := testCase.actualResult(NewDelayQueue(fc), fc)
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func TestDelayQueuePeekSizeAndClear(t *testing.T) {
+	dq := NewDelayQueue(clock.NewFakeClock(testEpoch))
+	dq.Add(2, 2*time.Second)
+	dq.Add(1, time.Second)
+
+	assert.Equal(t, 2, dq.Size())
+	assert.Equal(t, 1, dq.Peek())
+
+	dq.Clear()
+	assert.True(t, dq.Empty())
+}
+
+func TestDelayQueuePanics(t *testing.T) {
+	testCases := []struct {
+		name         string
+		actualResult func(dq *DelayQueue) int
+	}{
+		{
+			name: "test dequeue with no expired element",
+			actualResult: func(dq *DelayQueue) int {
+				dq.Add(1, time.Second)
+				return dq.Dequeue()
+			},
+		},
+		{
+			name: "test peek on empty delay queue",
+			actualResult: func(dq *DelayQueue) int {
+				return dq.Peek()
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("didn't panic")
+				}
+			}()
+			testCase.actualResult(NewDelayQueue(clock.NewFakeClock(testEpoch)))
+		})
+	}
+}
+
+func TestDelayQueueTakeWaitsForDeadline(t *testing.T) {
+	fc := clock.NewFakeClock(testEpoch)
+	dq := NewDelayQueue(fc)
+	dq.Add(1, 10*time.Second)
+
+	done := make(chan int)
+	go func() {
+		e, _ := dq.Take(context.Background())
+		done <- e
+	}()
+
+	fc.BlockUntil(1)
+	fc.Advance(9 * time.Second)
+	select {
+	case <-done:
+		t.Fatal("Take() returned before the element was due")
+	default:
+	}
+
+	fc.Advance(time.Second)
+	select {
+	case e := <-done:
+		assert.Equal(t, 1, e)
+	case <-time.After(testWaitTimeout):
+		t.Fatal("Take() was not woken up when the element became due")
+	}
+}
+
+func TestDelayQueueTakeSeesEarlierElement(t *testing.T) {
+	fc := clock.NewFakeClock(testEpoch)
+	dq := NewDelayQueue(fc)
+	dq.Add(1, time.Hour)
+
+	done := make(chan int)
+	go func() {
+		e, _ := dq.Take(context.Background())
+		done <- e
+	}()
+
+	fc.BlockUntil(1)
+	dq.Add(2, time.Second)
+	fc.Advance(time.Second)
+
+	select {
+	case e := <-done:
+		assert.Equal(t, 2, e)
+	case <-time.After(testWaitTimeout):
+		t.Fatal("Take() did not pick up the earlier element")
+	}
+	assert.Equal(t, 1, dq.Size())
+}
+
+func TestDelayQueueTakeOnEmptyWaitsForAdd(t *testing.T) {
+	dq := NewDelayQueue(clock.NewFakeClock(testEpoch))
+
+	done := make(chan int)
+	go func() {
+		e, _ := dq.Take(context.Background())
+		done <- e
+	}()
+
+	dq.Enqueue(7)
+	select {
+	case e := <-done:
+		assert.Equal(t, 7, e)
+	case <-time.After(testWaitTimeout):
+		t.Fatal("Take() was not woken up by Enqueue()")
+	}
+}
+
+func TestDelayQueueTakeCancelled(t *testing.T) {
+	fc := clock.NewFakeClock(testEpoch)
+	dq := NewDelayQueue(fc)
+	dq.Add(1, time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		_, err := dq.Take(ctx)
+		done <- err
+	}()
+
+	fc.BlockUntil(1)
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.Equal(t, context.Canceled, err)
+	case <-time.After(testWaitTimeout):
+		t.Fatal("Take() ignored context cancellation")
+	}
+	assert.Equal(t, 0, fc.PendingTimers())
+	assert.Equal(t, 1, dq.Size())
+}
+
+func TestDelayQueueWithRealClock(t *testing.T) {
+	dq := NewDelayQueue(nil)
+	dq.Add(1, time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), testWaitTimeout)
+	defer cancel()
+
+	e, err := dq.Take(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, e)
+}
+
+func testPollAll(dq *DelayQueue) []int {
+	var res []int
+	for {
+		e, ok := dq.Poll()
+		if !ok {
+			return res
+		}
+		res = append(res, e)
+	}
+}
@@ -1 +1,16 @@
 package queue
+
+import "github.com/rewantsoni/go-datastructures/iterator"
+
+type Deque interface {
+	AddFirst(element int) bool
+	AddLast(element int) bool
+	Clear()
+	DescendingIterator() iterator.Iterator
+	Iterator() iterator.Iterator
+	PeekFirst() int
+	PeekLast() int
+	RemoveFirst() int
+	RemoveLast() int
+	Size() int
+}
@@ -1 +1,154 @@
 package queue
+
+import (
+	"github.com/rewantsoni/go-datastructures/list"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var dequeImplementations = []struct {
+	name   string
+	create func() Deque
+}{
+	{
+		name:   "array deque",
+		create: func() Deque { return NewArrayDeque() },
+	},
+	{
+		name:   "linked list",
+		create: func() Deque { return list.NewLinkedList() },
+	},
+}
+
+func TestDequeOperations(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func(d Deque) []int
+		expectedResult []int
+	}{
+		{
+			name: "test add first and peek both ends",
+			actualResult: func(d Deque) []int {
+				d.AddFirst(1)
+				d.AddFirst(2)
+				d.AddFirst(3)
+				return []int{d.PeekFirst(), d.PeekLast(), d.Size()}
+			},
+			expectedResult: []int{3, 1, 3},
+		},
+		{
+			name: "test add last and peek both ends",
+			actualResult: func(d Deque) []int {
+				d.AddLast(1)
+				d.AddLast(2)
+				d.AddLast(3)
+				return []int{d.PeekFirst(), d.PeekLast(), d.Size()}
+			},
+			expectedResult: []int{1, 3, 3},
+		},
+		{
+			name: "test remove from both ends",
+			actualResult: func(d Deque) []int {
+				d.AddLast(1)
+				d.AddLast(2)
+				d.AddFirst(0)
+				d.AddLast(3)
+				return []int{d.RemoveFirst(), d.RemoveLast(), d.RemoveFirst(), d.RemoveLast(), d.Size()}
+			},
+			expectedResult: []int{0, 3, 1, 2, 0},
+		},
+		{
+			name: "test peek last after removing the last element",
+			actualResult: func(d Deque) []int {
+				d.AddLast(1)
+				d.AddLast(2)
+				d.RemoveLast()
+				return []int{d.PeekLast(), d.PeekFirst()}
+			},
+			expectedResult: []int{1, 1},
+		},
+		{
+			name: "test iterator visits elements from first to last",
+			actualResult: func(d Deque) []int {
+				d.AddLast(2)
+				d.AddFirst(1)
+				d.AddLast(3)
+				return testCollect(d.Iterator())
+			},
+			expectedResult: []int{1, 2, 3},
+		},
+		{
+			name: "test descending iterator visits elements from last to first",
+			actualResult: func(d Deque) []int {
+				d.AddLast(2)
+				d.AddFirst(1)
+				d.AddLast(3)
+				return testCollect(d.DescendingIterator())
+			},
+			expectedResult: []int{3, 2, 1},
+		},
+		{
+			name: "test clear deque",
+			actualResult: func(d Deque) []int {
+				d.AddLast(1)
+				d.AddLast(2)
+				d.Clear()
+				d.AddFirst(5)
+				return []int{d.Size(), d.PeekFirst(), d.PeekLast()}
+			},
+			expectedResult: []int{1, 5, 5},
+		},
+	}
+	for _, impl := range dequeImplementations {
+		for _, testCase := range testCases {
+			t.Run(impl.name+"/"+testCase.name, func(t *testing.T) {
+				assert.Equal(t, testCase.expectedResult, testCase.actualResult(impl.create()))
+			})
+		}
+	}
+}
+
+func TestDequeEmptyPanics(t *testing.T) {
+	testCases := []struct {
+		name         string
+		actualResult func(d Deque)
+	}{
+		{
+			name:         "test peek first on empty deque",
+			actualResult: func(d Deque) { d.PeekFirst() },
+		},
+		{
+			name:         "test peek last on empty deque",
+			actualResult: func(d Deque) { d.PeekLast() },
+		},
+		{
+			name:         "test remove first on empty deque",
+			actualResult: func(d Deque) { d.RemoveFirst() },
+		},
+		{
+			name:         "test remove last on empty deque",
+			actualResult: func(d Deque) { d.RemoveLast() },
+		},
+		{
+			name: "test peek last on deque emptied by removes",
+			actualResult: func(d Deque) {
+				d.AddLast(1)
+				d.RemoveFirst()
+				d.PeekLast()
+			},
+		},
+	}
+	for _, impl := range dequeImplementations {
+		for _, testCase := range testCases {
+			t.Run(impl.name+"/"+testCase.name, func(t *testing.T) {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("%s did not panic on an empty deque", impl.name)
+					}
+				}()
+
+				testCase.actualResult(impl.create())
+			})
+		}
+	}
+}
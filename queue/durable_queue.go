@@ -0,0 +1,463 @@
+package queue
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+type SyncPolicy int
+
+const (
+	SyncAlways SyncPolicy = iota
+	SyncBatch
+	SyncNever
+)
+
+const (
+	defaultSegmentRecords = 1024
+	defaultSyncEvery      = 64
+	segmentExtension      = ".seg"
+
+	// crc32 | op | seq | value
+	recordSize = 4 + 1 + 8 + 8
+
+	opEnqueue byte = 1
+	opDequeue byte = 2
+)
+
+type DurableOptions struct {
+	// SegmentRecords is how many records a segment file holds before a new one is started.
+	SegmentRecords int
+	Sync           SyncPolicy
+	// SyncEvery is how many records SyncBatch lets through between fsyncs.
+	SyncEvery int
+}
+
+// DurableQueue is a Queue whose every change is first appended to a write-ahead log of checksummed,
+// fixed-size records spread over segment files in dir. An enqueue record carries the element and its
+// sequence number; a dequeue record carries the sequence number of the new head, so replaying the log in
+// order rebuilds the queue. Segments whose elements have all been dequeued are deleted.
+type DurableQueue struct {
+	dir      string
+	opts     DurableOptions
+	items    *ArrayDeque
+	head     uint64
+	next     uint64
+	segments []*segment
+	active   logFile
+	unsynced int
+	err      error
+	// failed is set when a bad write could not be rolled back; the log may hold a partial record, so every
+	// later append is refused
+	failed error
+}
+
+// logFile is the part of *os.File the log writes through.
+type logFile interface {
+	io.WriteCloser
+	Sync() error
+	Truncate(size int64) error
+}
+
+type segment struct {
+	id         uint64
+	records    int
+	hasEnqueue bool
+	lastSeq    uint64
+}
+
+type record struct {
+	op    byte
+	seq   uint64
+	value int
+}
+
+// OpenDurableQueue recovers the queue stored in dir, creating dir if needed. Recovery stops at the first
+// torn or corrupt record: the segment is truncated there and any later segments are removed.
+func OpenDurableQueue(dir string, opts DurableOptions) (*DurableQueue, error) {
+	if opts.SegmentRecords <= 0 {
+		opts.SegmentRecords = defaultSegmentRecords
+	}
+	if opts.SyncEvery <= 0 {
+		opts.SyncEvery = defaultSyncEvery
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	dq := &DurableQueue{
+		dir:   dir,
+		opts:  opts,
+		items: NewArrayDeque(),
+	}
+
+	if err := dq.recover(); err != nil {
+		return nil, err
+	}
+	return dq, nil
+}
+
+func (dq *DurableQueue) Clear() {
+	if dq.append(record{op: opDequeue, seq: dq.next}) != nil {
+		return
+	}
+
+	dq.items.Clear()
+	dq.head = dq.next
+	dq.compact()
+}
+
+func (dq *DurableQueue) Close() error {
+	if dq.active == nil {
+		return dq.err
+	}
+
+	err := dq.active.Sync()
+	if cerr := dq.active.Close(); err == nil {
+		err = cerr
+	}
+	dq.active = nil
+	return err
+}
+
+// Dequeue panics if the queue is empty or the dequeue could not be logged; TryDequeue reports both as false.
+func (dq *DurableQueue) Dequeue() int {
+	e, ok := dq.TryDequeue()
+	if !ok {
+		if dq.err != nil {
+			panic(fmt.Sprintf("panic: durable queue: %v", dq.err))
+		}
+		panic("queue is empty")
+	}
+	return e
+}
+
+func (dq *DurableQueue) Empty() bool {
+	return dq.Size() == 0
+}
+
+func (dq *DurableQueue) Enqueue(element int) bool {
+	if dq.append(record{op: opEnqueue, seq: dq.next, value: element}) != nil {
+		return false
+	}
+
+	dq.items.AddLast(element)
+	dq.next++
+	return true
+}
+
+// Err returns the last error hit while writing to the log, if any.
+func (dq *DurableQueue) Err() error {
+	return dq.err
+}
+
+func (dq *DurableQueue) Peek() int {
+	return dq.items.PeekFirst()
+}
+
+func (dq *DurableQueue) Size() int {
+	return dq.items.Size()
+}
+
+func (dq *DurableQueue) Sync() error {
+	if dq.active == nil {
+		return dq.err
+	}
+
+	dq.unsynced = 0
+	return dq.active.Sync()
+}
+
+func (dq *DurableQueue) TryDequeue() (int, bool) {
+	if dq.Empty() {
+		return -1, false
+	}
+
+	if dq.append(record{op: opDequeue, seq: dq.head + 1}) != nil {
+		return -1, false
+	}
+
+	e := dq.items.RemoveFirst()
+	dq.head++
+	dq.compact()
+	return e, true
+}
+
+//Helper Functions
+func (dq *DurableQueue) recover() error {
+	ids, err := dq.segmentIDs()
+	if err != nil {
+		return err
+	}
+
+	var enqueued []record
+	for i, id := range ids {
+		seg, records, consistent, err := dq.replay(id)
+		if err != nil {
+			return err
+		}
+
+		dq.segments = append(dq.segments, seg)
+		for _, r := range records {
+			if r.op == opEnqueue {
+				enqueued = append(enqueued, r)
+				if r.seq >= dq.next {
+					dq.next = r.seq + 1
+				}
+			} else if r.seq > dq.head {
+				dq.head = r.seq
+			}
+		}
+
+		if !consistent {
+			for _, later := range ids[i+1:] {
+				if err := os.Remove(dq.segmentPath(later)); err != nil {
+					return err
+				}
+			}
+			break
+		}
+	}
+
+	if dq.head > dq.next {
+		dq.next = dq.head
+	}
+	for _, r := range enqueued {
+		if r.seq >= dq.head {
+			dq.items.AddLast(r.value)
+		}
+	}
+
+	return dq.openActive()
+}
+
+// replay reads every record of a segment, truncating it at the first one that is incomplete or fails its
+// checksum; consistent is false if that happened.
+func (dq *DurableQueue) replay(id uint64) (*segment, []record, bool, error) {
+	path := dq.segmentPath(id)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	seg := &segment{id: id}
+	var records []record
+	offset := 0
+	for ; offset+recordSize <= len(data); offset += recordSize {
+		r, ok := decodeRecord(data[offset : offset+recordSize])
+		if !ok {
+			break
+		}
+
+		records = append(records, r)
+		seg.track(r)
+	}
+
+	if offset == len(data) {
+		return seg, records, true, nil
+	}
+	return seg, records, false, os.Truncate(path, int64(offset))
+}
+
+// append writes r at the end of the active segment. A failed write or sync truncates the segment back to
+// where r started, so that a partial record does not shift the later ones and a rejected record is not
+// recovered.
+func (dq *DurableQueue) append(r record) error {
+	if dq.failed != nil {
+		return dq.failed
+	}
+	if dq.active == nil {
+		dq.err = os.ErrClosed
+		return dq.err
+	}
+
+	seg := dq.segments[len(dq.segments)-1]
+	if seg.records >= dq.opts.SegmentRecords {
+		if err := dq.rotate(); err != nil {
+			dq.err = err
+			return err
+		}
+		seg = dq.segments[len(dq.segments)-1]
+	}
+
+	offset := int64(seg.records) * recordSize
+	if _, err := dq.active.Write(encodeRecord(r)); err != nil {
+		return dq.rollback(offset, err)
+	}
+	if err := dq.maybeSync(); err != nil {
+		return dq.rollback(offset, err)
+	}
+
+	seg.track(r)
+	return nil
+}
+
+func (dq *DurableQueue) rollback(offset int64, cause error) error {
+	dq.err = cause
+
+	if err := dq.active.Truncate(offset); err != nil {
+		dq.failed = cause
+		return cause
+	}
+	if dq.opts.Sync != SyncNever {
+		if err := dq.active.Sync(); err != nil {
+			dq.failed = cause
+		}
+	}
+	return cause
+}
+
+func (dq *DurableQueue) maybeSync() error {
+	dq.unsynced++
+
+	switch dq.opts.Sync {
+	case SyncAlways:
+		return dq.Sync()
+	case SyncBatch:
+		if dq.unsynced >= dq.opts.SyncEvery {
+			return dq.Sync()
+		}
+	}
+	return nil
+}
+
+func (dq *DurableQueue) rotate() error {
+	if err := dq.Close(); err != nil {
+		return err
+	}
+
+	dq.segments = append(dq.segments, &segment{id: dq.segments[len(dq.segments)-1].id + 1})
+	return dq.openActive()
+}
+
+func (dq *DurableQueue) openActive() error {
+	if len(dq.segments) == 0 {
+		dq.segments = append(dq.segments, &segment{id: 0})
+	}
+
+	f, err := os.OpenFile(dq.segmentPath(dq.segments[len(dq.segments)-1].id), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+
+	// the segment may have just been created, or recovery may have removed later ones
+	if err := dq.syncDir(); err != nil {
+		f.Close()
+		return err
+	}
+
+	dq.active = f
+	return nil
+}
+
+// syncDir makes segment files created or removed in dir durable, unless the policy is SyncNever.
+func (dq *DurableQueue) syncDir() error {
+	if dq.opts.Sync == SyncNever {
+		return nil
+	}
+
+	d, err := os.Open(dq.dir)
+	if err != nil {
+		return err
+	}
+
+	err = d.Sync()
+	if cerr := d.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// compact deletes the oldest segments for as long as every element they hold has been dequeued. A dequeue
+// record is always written to the same or a later segment than the element it consumes, so nothing that
+// recovery still needs is lost.
+func (dq *DurableQueue) compact() {
+	removed := false
+	for len(dq.segments) > 1 {
+		seg := dq.segments[0]
+		if seg.hasEnqueue && seg.lastSeq >= dq.head {
+			break
+		}
+
+		if err := os.Remove(dq.segmentPath(seg.id)); err != nil {
+			dq.err = err
+			break
+		}
+		dq.segments = dq.segments[1:]
+		removed = true
+	}
+
+	if removed {
+		if err := dq.syncDir(); err != nil {
+			dq.err = err
+		}
+	}
+}
+
+func (dq *DurableQueue) segmentIDs() ([]uint64, error) {
+	entries, err := os.ReadDir(dq.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []uint64
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, segmentExtension) {
+			continue
+		}
+
+		var id uint64
+		if _, err := fmt.Sscanf(strings.TrimSuffix(name, segmentExtension), "%d", &id); err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+func (dq *DurableQueue) segmentPath(id uint64) string {
+	return filepath.Join(dq.dir, fmt.Sprintf("%020d%s", id, segmentExtension))
+}
+
+func (s *segment) track(r record) {
+	s.records++
+	if r.op == opEnqueue {
+		s.hasEnqueue = true
+		s.lastSeq = r.seq
+	}
+}
+
+func encodeRecord(r record) []byte {
+	buf := make([]byte, recordSize)
+	buf[4] = r.op
+	binary.LittleEndian.PutUint64(buf[5:], r.seq)
+	binary.LittleEndian.PutUint64(buf[13:], uint64(r.value))
+	binary.LittleEndian.PutUint32(buf[0:], crc32.ChecksumIEEE(buf[4:]))
+	return buf
+}
+
+func decodeRecord(buf []byte) (record, bool) {
+	if binary.LittleEndian.Uint32(buf[0:]) != crc32.ChecksumIEEE(buf[4:]) {
+		return record{}, false
+	}
+
+	r := record{
+		op:    buf[4],
+		seq:   binary.LittleEndian.Uint64(buf[5:]),
+		value: int(binary.LittleEndian.Uint64(buf[13:])),
+	}
+	if r.op != opEnqueue && r.op != opDequeue {
+		return record{}, false
+	}
+	return r, true
+}
@@ -0,0 +1,369 @@
+package queue
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDurableQueueRecoversAfterReopen(t *testing.T) {
+	testCases := []struct {
+		name           string
+		opts           DurableOptions
+		actualResult   func(dq *DurableQueue)
+		expectedResult []int
+	}{
+		{
+			name: "test reopen empty durable queue",
+			opts: DurableOptions{},
+			actualResult: func(dq *DurableQueue) {
+			},
+			expectedResult: nil,
+		},
+		{
+			name: "test reopen after enqueues",
+			opts: DurableOptions{Sync: SyncAlways},
+			actualResult: func(dq *DurableQueue) {
+				dq.Enqueue(1)
+				dq.Enqueue(2)
+				dq.Enqueue(3)
+			},
+			expectedResult: []int{1, 2, 3},
+		},
+		{
+			name: "test reopen after enqueues and dequeues",
+			opts: DurableOptions{Sync: SyncBatch, SyncEvery: 2},
+			actualResult: func(dq *DurableQueue) {
+				dq.Enqueue(1)
+				dq.Enqueue(2)
+				dq.Dequeue()
+				dq.Enqueue(3)
+			},
+			expectedResult: []int{2, 3},
+		},
+		{
+			name: "test reopen after clear",
+			opts: DurableOptions{Sync: SyncNever},
+			actualResult: func(dq *DurableQueue) {
+				dq.Enqueue(1)
+				dq.Enqueue(2)
+				dq.Clear()
+				dq.Enqueue(3)
+			},
+			expectedResult: []int{3},
+		},
+		{
+			name: "test reopen across segments",
+			opts: DurableOptions{SegmentRecords: 3},
+			actualResult: func(dq *DurableQueue) {
+				for i := 0; i < 10; i++ {
+					dq.Enqueue(i)
+				}
+				for i := 0; i < 4; i++ {
+					dq.Dequeue()
+				}
+			},
+			expectedResult: []int{4, 5, 6, 7, 8, 9},
+		},
+		{
+			name: "test reopen after every segment was compacted",
+			opts: DurableOptions{SegmentRecords: 2},
+			actualResult: func(dq *DurableQueue) {
+				for i := 0; i < 5; i++ {
+					dq.Enqueue(i)
+				}
+				for i := 0; i < 5; i++ {
+					dq.Dequeue()
+				}
+			},
+			expectedResult: nil,
+		},
+		{
+			name: "test reopen with negative elements",
+			opts: DurableOptions{},
+			actualResult: func(dq *DurableQueue) {
+				dq.Enqueue(-1)
+				dq.Enqueue(-1 << 40)
+			},
+			expectedResult: []int{-1, -1 << 40},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			dq, err := OpenDurableQueue(dir, testCase.opts)
+			require.NoError(t, err)
+			testCase.actualResult(dq)
+			require.NoError(t, dq.Err())
+			require.NoError(t, dq.Close())
+
+			reopened, err := OpenDurableQueue(dir, testCase.opts)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedResult, testDrainDurable(reopened))
+			require.NoError(t, reopened.Close())
+
+			// the dequeues made while draining must be durable as well
+			again, err := OpenDurableQueue(dir, testCase.opts)
+			require.NoError(t, err)
+			assert.True(t, again.Empty())
+			require.NoError(t, again.Close())
+		})
+	}
+}
+
+func TestDurableQueueContinuesAfterReopen(t *testing.T) {
+	dir := t.TempDir()
+
+	dq, err := OpenDurableQueue(dir, DurableOptions{SegmentRecords: 2})
+	require.NoError(t, err)
+	dq.Enqueue(1)
+	dq.Enqueue(2)
+	dq.Dequeue()
+	require.NoError(t, dq.Close())
+
+	dq, err = OpenDurableQueue(dir, DurableOptions{SegmentRecords: 2})
+	require.NoError(t, err)
+	dq.Enqueue(3)
+	assert.Equal(t, 2, dq.Dequeue())
+	require.NoError(t, dq.Close())
+
+	dq, err = OpenDurableQueue(dir, DurableOptions{SegmentRecords: 2})
+	require.NoError(t, err)
+	assert.Equal(t, []int{3}, testDrainDurable(dq))
+	require.NoError(t, dq.Close())
+}
+
+func TestDurableQueueCompaction(t *testing.T) {
+	dir := t.TempDir()
+
+	dq, err := OpenDurableQueue(dir, DurableOptions{SegmentRecords: 4, Sync: SyncNever})
+	require.NoError(t, err)
+	for i := 0; i < 16; i++ {
+		dq.Enqueue(i)
+	}
+	assert.Equal(t, 4, testSegmentCount(t, dir))
+
+	for i := 0; i < 12; i++ {
+		dq.Dequeue()
+	}
+	// the first three segments only hold consumed elements; the dequeue records went to new segments
+	assert.Equal(t, []int{12, 13, 14, 15}, testDrainDurable(dq))
+	assert.LessOrEqual(t, testSegmentCount(t, dir), 3)
+	require.NoError(t, dq.Close())
+}
+
+func TestDurableQueueTornWrite(t *testing.T) {
+	testCases := []struct {
+		name           string
+		corrupt        func(t *testing.T, path string)
+		expectedResult []int
+		expectedSize   int64
+	}{
+		{
+			name: "test recovery after a partially written record",
+			corrupt: func(t *testing.T, path string) {
+				info, err := os.Stat(path)
+				require.NoError(t, err)
+				require.NoError(t, os.Truncate(path, info.Size()-5))
+			},
+			expectedResult: []int{1, 2},
+			expectedSize:   2 * recordSize,
+		},
+		{
+			name: "test recovery after a few stray bytes",
+			corrupt: func(t *testing.T, path string) {
+				f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+				require.NoError(t, err)
+				_, err = f.Write([]byte{1, 2, 3})
+				require.NoError(t, err)
+				require.NoError(t, f.Close())
+			},
+			expectedResult: []int{1, 2, 3},
+			expectedSize:   3 * recordSize,
+		},
+		{
+			name: "test recovery after a record fails its checksum",
+			corrupt: func(t *testing.T, path string) {
+				data, err := os.ReadFile(path)
+				require.NoError(t, err)
+				data[recordSize+10] ^= 0xff
+				require.NoError(t, os.WriteFile(path, data, 0o644))
+			},
+			expectedResult: []int{1},
+			expectedSize:   recordSize,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			dq, err := OpenDurableQueue(dir, DurableOptions{})
+			require.NoError(t, err)
+			dq.Enqueue(1)
+			dq.Enqueue(2)
+			dq.Enqueue(3)
+			require.NoError(t, dq.Close())
+
+			path := filepath.Join(dir, "00000000000000000000.seg")
+			testCase.corrupt(t, path)
+
+			dq, err = OpenDurableQueue(dir, DurableOptions{})
+			require.NoError(t, err)
+			info, err := os.Stat(path)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedSize, info.Size())
+
+			// the log must be appendable again right after the recovered records
+			dq.Enqueue(4)
+			require.NoError(t, dq.Close())
+
+			dq, err = OpenDurableQueue(dir, DurableOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, append(testCase.expectedResult, 4), testDrainDurable(dq))
+			require.NoError(t, dq.Close())
+		})
+	}
+}
+
+func TestDurableQueueTornWriteDropsLaterSegments(t *testing.T) {
+	dir := t.TempDir()
+
+	dq, err := OpenDurableQueue(dir, DurableOptions{SegmentRecords: 2})
+	require.NoError(t, err)
+	for i := 1; i <= 6; i++ {
+		dq.Enqueue(i)
+	}
+	require.NoError(t, dq.Close())
+	assert.Equal(t, 3, testSegmentCount(t, dir))
+
+	require.NoError(t, os.Truncate(filepath.Join(dir, "00000000000000000001.seg"), recordSize+1))
+
+	dq, err = OpenDurableQueue(dir, DurableOptions{SegmentRecords: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 2, testSegmentCount(t, dir))
+	assert.Equal(t, []int{1, 2, 3}, testDrainDurable(dq))
+	require.NoError(t, dq.Close())
+}
+
+func TestDurableQueueFailedWrite(t *testing.T) {
+	testCases := []struct {
+		name             string
+		fault            faultyLogFile
+		expectedEnqueues []bool
+		expectedResult   []int
+	}{
+		{
+			name:             "test short write is rolled back",
+			fault:            faultyLogFile{shortWrite: true},
+			expectedEnqueues: []bool{false, true},
+			expectedResult:   []int{1, 2, 4},
+		},
+		{
+			name:             "test record whose fsync failed is not recovered",
+			fault:            faultyLogFile{failSync: true},
+			expectedEnqueues: []bool{false, true},
+			expectedResult:   []int{1, 2, 4},
+		},
+		{
+			name:             "test appends are refused once a short write cannot be rolled back",
+			fault:            faultyLogFile{shortWrite: true, failTruncate: true},
+			expectedEnqueues: []bool{false, false},
+			expectedResult:   []int{1, 2},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			dq, err := OpenDurableQueue(dir, DurableOptions{Sync: SyncAlways})
+			require.NoError(t, err)
+			dq.Enqueue(1)
+			dq.Enqueue(2)
+
+			fault := testCase.fault
+			fault.logFile = dq.active
+			dq.active = &fault
+			assert.Equal(t, testCase.expectedEnqueues, []bool{dq.Enqueue(3), dq.Enqueue(4)})
+			assert.Error(t, dq.Err())
+			require.NoError(t, dq.Close())
+
+			dq, err = OpenDurableQueue(dir, DurableOptions{Sync: SyncAlways})
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedResult, testDrainDurable(dq))
+			require.NoError(t, dq.Close())
+		})
+	}
+}
+
+func TestDurableQueueAfterClose(t *testing.T) {
+	dq, err := OpenDurableQueue(t.TempDir(), DurableOptions{})
+	require.NoError(t, err)
+	dq.Enqueue(1)
+	require.NoError(t, dq.Close())
+
+	assert.False(t, dq.Enqueue(2))
+	_, ok := dq.TryDequeue()
+	assert.False(t, ok)
+	assert.Equal(t, os.ErrClosed, dq.Err())
+	assert.Equal(t, 1, dq.Size())
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Dequeue() didn't panic on a closed durable queue")
+		}
+	}()
+	dq.Dequeue()
+}
+
+func testDrainDurable(dq *DurableQueue) []int {
+	var res []int
+	for !dq.Empty() {
+		res = append(res, dq.Dequeue())
+	}
+	return res
+}
+
+func testSegmentCount(t *testing.T, dir string) int {
+	matches, err := filepath.Glob(filepath.Join(dir, "*"+segmentExtension))
+	require.NoError(t, err)
+	return len(matches)
+}
+
+// faultyLogFile fails the first write, sync or truncate it is asked to fail; a failed write still writes
+// half of the record.
+type faultyLogFile struct {
+	logFile
+	shortWrite   bool
+	failSync     bool
+	failTruncate bool
+}
+
+func (f *faultyLogFile) Write(b []byte) (int, error) {
+	if !f.shortWrite {
+		return f.logFile.Write(b)
+	}
+
+	f.shortWrite = false
+	n, _ := f.logFile.Write(b[:len(b)/2])
+	return n, fmt.Errorf("injected short write")
+}
+
+func (f *faultyLogFile) Sync() error {
+	if f.failSync {
+		f.failSync = false
+		return fmt.Errorf("injected sync failure")
+	}
+	return f.logFile.Sync()
+}
+
+func (f *faultyLogFile) Truncate(size int64) error {
+	if f.failTruncate {
+		f.failTruncate = false
+		return fmt.Errorf("injected truncate failure")
+	}
+	return f.logFile.Truncate(size)
+}
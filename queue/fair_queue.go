@@ -0,0 +1,215 @@
+package queue
+
+type SchedulingPolicy int
+
+const (
+	// WeightedRoundRobin serves up to weight elements from a class before moving on to the next.
+	WeightedRoundRobin SchedulingPolicy = iota
+	// DeficitRoundRobin gives a class weight credit per round and charges each element its cost, so classes
+	// with expensive elements cannot take more than their share.
+	DeficitRoundRobin
+)
+
+type Classifier interface {
+	Classify(element int) string
+}
+
+// Coster may additionally be implemented by a Classifier to give elements a cost under DeficitRoundRobin;
+// without it every element costs one. A cost below one is charged as one, so a class cannot be served for
+// free.
+type Coster interface {
+	Cost(element int) int
+}
+
+// FairQueue keeps one sub-queue per class and interleaves them on Dequeue according to its policy, so one
+// busy class cannot starve the others. Enqueue routes elements through the Classifier.
+type FairQueue struct {
+	policy     SchedulingPolicy
+	classifier Classifier
+	classes    []*fairClass
+	current    int
+	size       int
+}
+
+type fairClass struct {
+	name   string
+	weight int
+	limit  int
+	queue  *ArrayDeque
+	// WeightedRoundRobin: elements left in this visit; DeficitRoundRobin: the deficit counter
+	credit  int
+	visited bool
+}
+
+func NewFairQueue(policy SchedulingPolicy, classifier Classifier) *FairQueue {
+	return &FairQueue{
+		policy:     policy,
+		classifier: classifier,
+	}
+}
+
+// AddClass registers a class with a positive weight and a size limit, where a limit of zero means unbounded.
+func (fq *FairQueue) AddClass(name string, weight int, limit int) bool {
+	if weight <= 0 || limit < 0 || fq.find(name) != -1 {
+		return false
+	}
+
+	fq.classes = append(fq.classes, &fairClass{
+		name:   name,
+		weight: weight,
+		limit:  limit,
+		queue:  NewArrayDeque(),
+	})
+	return true
+}
+
+func (fq *FairQueue) ClassSize(name string) int {
+	index := fq.find(name)
+	if index == -1 {
+		return 0
+	}
+	return fq.classes[index].queue.Size()
+}
+
+func (fq *FairQueue) Clear() {
+	for _, c := range fq.classes {
+		c.queue.Clear()
+		c.credit = 0
+		c.visited = false
+	}
+	fq.current = 0
+	fq.size = 0
+}
+
+func (fq *FairQueue) Dequeue() int {
+	c := fq.advance()
+	e := c.queue.RemoveFirst()
+	fq.size--
+
+	c.credit -= fq.cost(e)
+	if c.queue.Empty() || (fq.policy == WeightedRoundRobin && c.credit == 0) {
+		fq.next()
+	}
+	return e
+}
+
+func (fq *FairQueue) Empty() bool {
+	return fq.Size() == 0
+}
+
+func (fq *FairQueue) Enqueue(element int) bool {
+	if fq.classifier == nil {
+		return false
+	}
+	return fq.EnqueueTo(fq.classifier.Classify(element), element)
+}
+
+func (fq *FairQueue) EnqueueTo(name string, element int) bool {
+	index := fq.find(name)
+	if index == -1 {
+		return false
+	}
+
+	c := fq.classes[index]
+	if c.limit > 0 && c.queue.Size() >= c.limit {
+		return false
+	}
+
+	c.queue.AddLast(element)
+	fq.size++
+	return true
+}
+
+func (fq *FairQueue) Peek() int {
+	return fq.advance().queue.PeekFirst()
+}
+
+// RemoveClass drops a class together with every element still queued in it.
+func (fq *FairQueue) RemoveClass(name string) bool {
+	index := fq.find(name)
+	if index == -1 {
+		return false
+	}
+
+	fq.size -= fq.classes[index].queue.Size()
+	fq.classes = append(fq.classes[:index], fq.classes[index+1:]...)
+
+	switch {
+	case index < fq.current:
+		fq.current--
+	case index == fq.current && fq.current == len(fq.classes):
+		fq.current = 0
+	}
+	return true
+}
+
+func (fq *FairQueue) Size() int {
+	return fq.size
+}
+
+//Helper Functions
+
+// advance moves the cursor to the class that is due to be served and returns it. It only changes
+// scheduling state, so calling it again without a Dequeue in between returns the same class.
+func (fq *FairQueue) advance() *fairClass {
+	if fq.Empty() {
+		panic("queue is empty")
+	}
+
+	for {
+		c := fq.classes[fq.current]
+		if c.queue.Empty() {
+			fq.next()
+			continue
+		}
+
+		if !c.visited {
+			c.visited = true
+			if fq.policy == WeightedRoundRobin {
+				c.credit = c.weight
+			} else {
+				c.credit += c.weight
+			}
+		}
+
+		if fq.cost(c.queue.PeekFirst()) <= c.credit {
+			return c
+		}
+		fq.next()
+	}
+}
+
+func (fq *FairQueue) next() {
+	c := fq.classes[fq.current]
+	c.visited = false
+	if c.queue.Empty() || fq.policy == WeightedRoundRobin {
+		c.credit = 0
+	}
+
+	fq.current = (fq.current + 1) % len(fq.classes)
+}
+
+func (fq *FairQueue) cost(element int) int {
+	if fq.policy == WeightedRoundRobin {
+		return 1
+	}
+
+	coster, ok := fq.classifier.(Coster)
+	if !ok {
+		return 1
+	}
+
+	if cost := coster.Cost(element); cost > 1 {
+		return cost
+	}
+	return 1
+}
+
+func (fq *FairQueue) find(name string) int {
+	for i, c := range fq.classes {
+		if c.name == name {
+			return i
+		}
+	}
+	return -1
+}
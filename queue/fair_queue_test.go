@@ -0,0 +1,207 @@
+package queue
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type testTenantClassifier struct{}
+
+// elements below 100 belong to tenant a, the rest to tenant b; the last digit is the cost
+func (testTenantClassifier) Classify(element int) string {
+	if element < 100 {
+		return "a"
+	}
+	return "b"
+}
+
+func (testTenantClassifier) Cost(element int) int {
+	return element % 10
+}
+
+func TestFairQueueScheduling(t *testing.T) {
+	testCases := []struct {
+		name           string
+		policy         SchedulingPolicy
+		classifier     Classifier
+		weights        map[string]int
+		elements       []int
+		expectedResult []int
+	}{
+		{
+			name:           "test weighted round robin",
+			policy:         WeightedRoundRobin,
+			classifier:     testTenantClassifier{},
+			weights:        map[string]int{"a": 2, "b": 1},
+			elements:       []int{1, 2, 3, 4, 101, 102, 103, 104},
+			expectedResult: []int{1, 2, 101, 3, 4, 102, 103, 104},
+		},
+		{
+			name:           "test weighted round robin skips empty classes",
+			policy:         WeightedRoundRobin,
+			classifier:     testTenantClassifier{},
+			weights:        map[string]int{"a": 1, "b": 1},
+			elements:       []int{101, 102, 103},
+			expectedResult: []int{101, 102, 103},
+		},
+		{
+			name:           "test deficit round robin charges element cost",
+			policy:         DeficitRoundRobin,
+			classifier:     testTenantClassifier{},
+			weights:        map[string]int{"a": 3, "b": 3},
+			elements:       []int{12, 22, 32, 101, 111, 121, 131},
+			expectedResult: []int{12, 101, 111, 121, 22, 32, 131},
+		},
+		{
+			name:           "test deficit round robin with elements costlier than the quantum",
+			policy:         DeficitRoundRobin,
+			classifier:     testTenantClassifier{},
+			weights:        map[string]int{"a": 2, "b": 2},
+			elements:       []int{5, 101, 111, 121, 131, 141},
+			expectedResult: []int{101, 111, 121, 131, 5, 141},
+		},
+		{
+			name:           "test deficit round robin charges zero cost elements as one",
+			policy:         DeficitRoundRobin,
+			classifier:     testTenantClassifier{},
+			weights:        map[string]int{"a": 2, "b": 2},
+			elements:       []int{10, 20, 30, 40, 101, 111, 121},
+			expectedResult: []int{10, 20, 101, 111, 30, 40, 121},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			fq := NewFairQueue(testCase.policy, testCase.classifier)
+			assert.True(t, fq.AddClass("a", testCase.weights["a"], 0))
+			assert.True(t, fq.AddClass("b", testCase.weights["b"], 0))
+
+			for _, e := range testCase.elements {
+				assert.True(t, fq.Enqueue(e))
+			}
+			assert.Equal(t, len(testCase.elements), fq.Size())
+
+			var res []int
+			for !fq.Empty() {
+				peeked := fq.Peek()
+				assert.Equal(t, peeked, fq.Peek())
+				e := fq.Dequeue()
+				assert.Equal(t, peeked, e)
+				res = append(res, e)
+			}
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func TestFairQueueClasses(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func(fq *FairQueue) []interface{}
+		expectedResult []interface{}
+	}{
+		{
+			name: "test add class rejects invalid arguments",
+			actualResult: func(fq *FairQueue) []interface{} {
+				return []interface{}{fq.AddClass("a", 1, 0), fq.AddClass("c", 0, 0), fq.AddClass("c", 1, -1)}
+			},
+			expectedResult: []interface{}{false, false, false},
+		},
+		{
+			name: "test enqueue to unknown class",
+			actualResult: func(fq *FairQueue) []interface{} {
+				return []interface{}{fq.EnqueueTo("c", 1), fq.Size()}
+			},
+			expectedResult: []interface{}{false, 0},
+		},
+		{
+			name: "test enqueue past class limit",
+			actualResult: func(fq *FairQueue) []interface{} {
+				fq.AddClass("c", 1, 2)
+				return []interface{}{fq.EnqueueTo("c", 1), fq.EnqueueTo("c", 2), fq.EnqueueTo("c", 3), fq.ClassSize("c")}
+			},
+			expectedResult: []interface{}{true, true, false, 2},
+		},
+		{
+			name: "test remove class drops its elements",
+			actualResult: func(fq *FairQueue) []interface{} {
+				fq.Enqueue(1)
+				fq.Enqueue(101)
+				fq.Enqueue(102)
+				return []interface{}{fq.RemoveClass("b"), fq.RemoveClass("b"), fq.Size(), fq.ClassSize("b"), fq.Dequeue()}
+			},
+			expectedResult: []interface{}{true, false, 1, 0, 1},
+		},
+		{
+			name: "test remove class being served",
+			actualResult: func(fq *FairQueue) []interface{} {
+				fq.AddClass("c", 1, 0)
+				fq.Enqueue(1)
+				fq.Enqueue(2)
+				fq.Enqueue(101)
+				fq.EnqueueTo("c", 201)
+				first := fq.Dequeue()
+				fq.RemoveClass("a")
+				return []interface{}{first, fq.Dequeue(), fq.Dequeue(), fq.Empty()}
+			},
+			expectedResult: []interface{}{1, 101, 201, true},
+		},
+		{
+			name: "test remove last class while it is being served",
+			actualResult: func(fq *FairQueue) []interface{} {
+				fq.Enqueue(1)
+				fq.Enqueue(101)
+				fq.Enqueue(102)
+				first := fq.Dequeue()
+				second := fq.Dequeue()
+				fq.RemoveClass("b")
+				fq.Enqueue(2)
+				return []interface{}{first, second, fq.Dequeue(), fq.Empty()}
+			},
+			expectedResult: []interface{}{1, 101, 2, true},
+		},
+		{
+			name: "test add class at runtime joins the rotation",
+			actualResult: func(fq *FairQueue) []interface{} {
+				fq.Enqueue(1)
+				fq.Enqueue(2)
+				first := fq.Dequeue()
+				fq.AddClass("c", 1, 0)
+				fq.EnqueueTo("c", 201)
+				fq.Enqueue(101)
+				return []interface{}{first, fq.Dequeue(), fq.Dequeue(), fq.Dequeue()}
+			},
+			expectedResult: []interface{}{1, 101, 201, 2},
+		},
+		{
+			name: "test clear",
+			actualResult: func(fq *FairQueue) []interface{} {
+				fq.Enqueue(1)
+				fq.Enqueue(101)
+				fq.Clear()
+				fq.Enqueue(102)
+				return []interface{}{fq.Size(), fq.Dequeue(), fq.Empty()}
+			},
+			expectedResult: []interface{}{1, 102, true},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			fq := NewFairQueue(WeightedRoundRobin, testTenantClassifier{})
+			fq.AddClass("a", 1, 0)
+			fq.AddClass("b", 1, 0)
+			assert.Equal(t, testCase.expectedResult, testCase.actualResult(fq))
+		})
+	}
+}
+
+func TestFairQueueWithoutClassifier(t *testing.T) {
+	var q Queue = NewFairQueue(DeficitRoundRobin, nil)
+	assert.False(t, q.Enqueue(1))
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Dequeue() didn't panic on empty fair queue")
+		}
+	}()
+	q.Dequeue()
+}
@@ -0,0 +1,218 @@
+package queue
+
+import "github.com/rewantsoni/go-datastructures/operators"
+
+// IndexedPriorityQueue is a binary heap of integer keys, each with its own priority, that tracks where every
+// key sits in the heap so that its priority can be changed or the key removed in O(log n). Min or max order
+// is decided by the comparator applied to priorities.
+//
+// On the queue.Queue side an element is its own priority. Enqueued elements are kept apart from keys: equal
+// elements are all kept, and none of them can be reached through the keyed methods.
+type IndexedPriorityQueue struct {
+	comparator operators.Comparator
+	entries    []ipqEntry
+	// heap positions of the keyed entries
+	positions map[int]int
+}
+
+// ipqEntry holds a key inserted with Insert, or an element added with Enqueue when keyed is false.
+type ipqEntry struct {
+	key      int
+	priority int
+	keyed    bool
+}
+
+func NewIndexedPriorityQueue(comparator operators.Comparator) *IndexedPriorityQueue {
+	if comparator == nil {
+		comparator = operators.NaturalOrder{}
+	}
+
+	return &IndexedPriorityQueue{
+		comparator: comparator,
+		entries:    make([]ipqEntry, nought, initialCapacity),
+		positions:  map[int]int{},
+	}
+}
+
+func (ipq *IndexedPriorityQueue) ChangePriority(key int, priority int) bool {
+	index, ok := ipq.positions[key]
+	if !ok {
+		return false
+	}
+
+	ipq.entries[index].priority = priority
+	if !ipq.siftUp(index) {
+		ipq.siftDown(index)
+	}
+	return true
+}
+
+func (ipq *IndexedPriorityQueue) Clear() {
+	ipq.entries = make([]ipqEntry, nought, initialCapacity)
+	ipq.positions = map[int]int{}
+}
+
+func (ipq *IndexedPriorityQueue) Contains(key int) bool {
+	_, ok := ipq.positions[key]
+	return ok
+}
+
+// DecreaseKey lowers the priority of key, and returns false if priority is not lower than the current one.
+func (ipq *IndexedPriorityQueue) DecreaseKey(key int, priority int) bool {
+	current, ok := ipq.Priority(key)
+	if !ok || priority >= current {
+		return false
+	}
+	return ipq.ChangePriority(key, priority)
+}
+
+func (ipq *IndexedPriorityQueue) Delete(key int) bool {
+	index, ok := ipq.positions[key]
+	if !ok {
+		return false
+	}
+
+	ipq.removeAt(index)
+	return true
+}
+
+func (ipq *IndexedPriorityQueue) Dequeue() int {
+	key, _ := ipq.PopMin()
+	return key
+}
+
+func (ipq *IndexedPriorityQueue) Empty() bool {
+	return ipq.Size() == 0
+}
+
+func (ipq *IndexedPriorityQueue) Enqueue(element int) bool {
+	ipq.push(ipqEntry{key: element, priority: element})
+	return true
+}
+
+// IncreaseKey raises the priority of key, and returns false if priority is not higher than the current one.
+func (ipq *IndexedPriorityQueue) IncreaseKey(key int, priority int) bool {
+	current, ok := ipq.Priority(key)
+	if !ok || priority <= current {
+		return false
+	}
+	return ipq.ChangePriority(key, priority)
+}
+
+func (ipq *IndexedPriorityQueue) Insert(key int, priority int) bool {
+	if ipq.Contains(key) {
+		return false
+	}
+
+	ipq.push(ipqEntry{key: key, priority: priority, keyed: true})
+	return true
+}
+
+func (ipq *IndexedPriorityQueue) Peek() int {
+	key, _ := ipq.PeekMin()
+	return key
+}
+
+func (ipq *IndexedPriorityQueue) PeekMin() (int, int) {
+	if ipq.Empty() {
+		panic("queue is empty")
+	}
+
+	return ipq.entries[0].key, ipq.entries[0].priority
+}
+
+func (ipq *IndexedPriorityQueue) PopMin() (int, int) {
+	if ipq.Empty() {
+		panic("queue is empty")
+	}
+
+	e := ipq.entries[0]
+	ipq.removeAt(0)
+
+	return e.key, e.priority
+}
+
+func (ipq *IndexedPriorityQueue) Priority(key int) (int, bool) {
+	index, ok := ipq.positions[key]
+	if !ok {
+		return -1, false
+	}
+	return ipq.entries[index].priority, true
+}
+
+func (ipq *IndexedPriorityQueue) Size() int {
+	return len(ipq.entries)
+}
+
+//Helper Functions
+func (ipq *IndexedPriorityQueue) push(e ipqEntry) {
+	ipq.entries = append(ipq.entries, e)
+	last := ipq.Size() - 1
+	if e.keyed {
+		ipq.positions[e.key] = last
+	}
+	ipq.siftUp(last)
+}
+
+func (ipq *IndexedPriorityQueue) less(i, j int) bool {
+	return ipq.comparator.Compare(ipq.entries[i].priority, ipq.entries[j].priority) < 0
+}
+
+func (ipq *IndexedPriorityQueue) swap(i, j int) {
+	ipq.entries[i], ipq.entries[j] = ipq.entries[j], ipq.entries[i]
+	for _, index := range []int{i, j} {
+		if ipq.entries[index].keyed {
+			ipq.positions[ipq.entries[index].key] = index
+		}
+	}
+}
+
+func (ipq *IndexedPriorityQueue) siftUp(index int) bool {
+	moved := false
+	for index > 0 {
+		parent := (index - 1) / 2
+		if !ipq.less(index, parent) {
+			break
+		}
+
+		ipq.swap(index, parent)
+		index = parent
+		moved = true
+	}
+	return moved
+}
+
+func (ipq *IndexedPriorityQueue) siftDown(index int) {
+	for {
+		smallest := index
+		left, right := 2*index+1, 2*index+2
+
+		if left < ipq.Size() && ipq.less(left, smallest) {
+			smallest = left
+		}
+		if right < ipq.Size() && ipq.less(right, smallest) {
+			smallest = right
+		}
+		if smallest == index {
+			return
+		}
+
+		ipq.swap(index, smallest)
+		index = smallest
+	}
+}
+
+func (ipq *IndexedPriorityQueue) removeAt(index int) {
+	last := ipq.Size() - 1
+	e := ipq.entries[index]
+
+	ipq.swap(index, last)
+	ipq.entries = ipq.entries[:last]
+	if e.keyed {
+		delete(ipq.positions, e.key)
+	}
+
+	if index < last && !ipq.siftUp(index) {
+		ipq.siftDown(index)
+	}
+}
@@ -0,0 +1,230 @@
+package queue
+
+import (
+	"github.com/rewantsoni/go-datastructures/operators"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+func TestIndexedPriorityQueueOperations(t *testing.T) {
+	testCases := []struct {
+		name           string
+		comparator     operators.Comparator
+		actualResult   func(ipq *IndexedPriorityQueue) bool
+		expectedResult bool
+		expectedOrder  [][2]int
+	}{
+		{
+			name:       "test insert existing key",
+			comparator: nil,
+			actualResult: func(ipq *IndexedPriorityQueue) bool {
+				return ipq.Insert(1, 100)
+			},
+			expectedResult: false,
+			expectedOrder:  [][2]int{{3, 10}, {1, 20}, {2, 30}},
+		},
+		{
+			name:       "test change priority to the front",
+			comparator: nil,
+			actualResult: func(ipq *IndexedPriorityQueue) bool {
+				return ipq.ChangePriority(2, 5)
+			},
+			expectedResult: true,
+			expectedOrder:  [][2]int{{2, 5}, {3, 10}, {1, 20}},
+		},
+		{
+			name:       "test change priority to the back",
+			comparator: nil,
+			actualResult: func(ipq *IndexedPriorityQueue) bool {
+				return ipq.ChangePriority(3, 40)
+			},
+			expectedResult: true,
+			expectedOrder:  [][2]int{{1, 20}, {2, 30}, {3, 40}},
+		},
+		{
+			name:       "test change priority of missing key",
+			comparator: nil,
+			actualResult: func(ipq *IndexedPriorityQueue) bool {
+				return ipq.ChangePriority(4, 1)
+			},
+			expectedResult: false,
+			expectedOrder:  [][2]int{{3, 10}, {1, 20}, {2, 30}},
+		},
+		{
+			name:       "test decrease key with a higher priority",
+			comparator: nil,
+			actualResult: func(ipq *IndexedPriorityQueue) bool {
+				return ipq.DecreaseKey(1, 25)
+			},
+			expectedResult: false,
+			expectedOrder:  [][2]int{{3, 10}, {1, 20}, {2, 30}},
+		},
+		{
+			name:       "test decrease key",
+			comparator: nil,
+			actualResult: func(ipq *IndexedPriorityQueue) bool {
+				return ipq.DecreaseKey(1, 1)
+			},
+			expectedResult: true,
+			expectedOrder:  [][2]int{{1, 1}, {3, 10}, {2, 30}},
+		},
+		{
+			name:       "test increase key on max queue",
+			comparator: operators.ReverseOrder{},
+			actualResult: func(ipq *IndexedPriorityQueue) bool {
+				return ipq.IncreaseKey(3, 35)
+			},
+			expectedResult: true,
+			expectedOrder:  [][2]int{{3, 35}, {2, 30}, {1, 20}},
+		},
+		{
+			name:       "test increase key with a lower priority",
+			comparator: operators.ReverseOrder{},
+			actualResult: func(ipq *IndexedPriorityQueue) bool {
+				return ipq.IncreaseKey(3, 5)
+			},
+			expectedResult: false,
+			expectedOrder:  [][2]int{{2, 30}, {1, 20}, {3, 10}},
+		},
+		{
+			name:       "test delete key",
+			comparator: nil,
+			actualResult: func(ipq *IndexedPriorityQueue) bool {
+				return ipq.Delete(3) && !ipq.Contains(3)
+			},
+			expectedResult: true,
+			expectedOrder:  [][2]int{{1, 20}, {2, 30}},
+		},
+		{
+			name:       "test delete missing key",
+			comparator: nil,
+			actualResult: func(ipq *IndexedPriorityQueue) bool {
+				return ipq.Delete(4)
+			},
+			expectedResult: false,
+			expectedOrder:  [][2]int{{3, 10}, {1, 20}, {2, 30}},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ipq := NewIndexedPriorityQueue(testCase.comparator)
+			ipq.Insert(1, 20)
+			ipq.Insert(2, 30)
+			ipq.Insert(3, 10)
+
+			assert.Equal(t, testCase.expectedResult, testCase.actualResult(ipq))
+
+			var res [][2]int
+			for !ipq.Empty() {
+				key, priority := ipq.PopMin()
+				res = append(res, [2]int{key, priority})
+			}
+			assert.Equal(t, testCase.expectedOrder, res)
+		})
+	}
+}
+
+func TestIndexedPriorityQueueAsQueue(t *testing.T) {
+	var q Queue = NewIndexedPriorityQueue(nil)
+	assert.True(t, q.Enqueue(3))
+	assert.True(t, q.Enqueue(1))
+	assert.True(t, q.Enqueue(3), "a duplicate element must be kept like in every other queue")
+	assert.Equal(t, 3, q.Size())
+	assert.Equal(t, 1, q.Peek())
+	assert.Equal(t, []int{1, 3, 3}, []int{q.Dequeue(), q.Dequeue(), q.Dequeue()})
+
+	q.Clear()
+	assert.True(t, q.Empty())
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Dequeue() didn't panic on empty indexed priority queue")
+		}
+	}()
+	q.Dequeue()
+}
+
+func TestIndexedPriorityQueueKeepsEnqueuedElementsApartFromKeys(t *testing.T) {
+	ipq := NewIndexedPriorityQueue(nil)
+	ipq.Enqueue(5)
+	assert.True(t, ipq.Insert(5, 2), "an enqueued element must not occupy the key")
+	ipq.Enqueue(1)
+
+	assert.True(t, ipq.ChangePriority(5, 7))
+	priority, _ := ipq.Priority(5)
+	assert.Equal(t, 7, priority)
+
+	var res [][2]int
+	for !ipq.Empty() {
+		key, priority := ipq.PopMin()
+		res = append(res, [2]int{key, priority})
+	}
+	assert.Equal(t, [][2]int{{1, 1}, {5, 5}, {5, 7}}, res)
+	assert.False(t, ipq.Contains(5))
+}
+
+func TestIndexedPriorityQueueDijkstra(t *testing.T) {
+	// 0 -> 1 (4), 0 -> 2 (1), 2 -> 1 (2), 1 -> 3 (1), 2 -> 3 (5)
+	edges := map[int][][2]int{
+		0: {{1, 4}, {2, 1}},
+		1: {{3, 1}},
+		2: {{1, 2}, {3, 5}},
+	}
+
+	dist := map[int]int{0: 0}
+	ipq := NewIndexedPriorityQueue(nil)
+	ipq.Insert(0, 0)
+
+	for !ipq.Empty() {
+		u, d := ipq.PopMin()
+		for _, edge := range edges[u] {
+			v, w := edge[0], edge[1]
+			if current, ok := dist[v]; ok && current <= d+w {
+				continue
+			}
+
+			dist[v] = d + w
+			if !ipq.DecreaseKey(v, d+w) {
+				ipq.Insert(v, d+w)
+			}
+		}
+	}
+
+	assert.Equal(t, map[int]int{0: 0, 1: 3, 2: 1, 3: 4}, dist)
+}
+
+func TestIndexedPriorityQueueRandomised(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	ipq := NewIndexedPriorityQueue(nil)
+	expected := map[int]int{}
+
+	for i := 0; i < 3000; i++ {
+		key := r.Intn(50)
+		switch r.Intn(4) {
+		case 0:
+			if ipq.Insert(key, r.Intn(1000)) {
+				expected[key], _ = ipq.Priority(key)
+			}
+		case 1:
+			priority := r.Intn(1000)
+			if ipq.ChangePriority(key, priority) {
+				expected[key] = priority
+			}
+		case 2:
+			assert.Equal(t, ipq.Contains(key), ipq.Delete(key))
+			delete(expected, key)
+		default:
+			if ipq.Empty() {
+				continue
+			}
+			key, priority := ipq.PopMin()
+			assert.Equal(t, expected[key], priority)
+			for _, p := range expected {
+				assert.LessOrEqual(t, priority, p)
+			}
+			delete(expected, key)
+		}
+		assert.Equal(t, len(expected), ipq.Size())
+	}
+}
@@ -0,0 +1,130 @@
+package queue
+
+import (
+	"sync/atomic"
+	"unsafe"
+)
+
+// LockFreeQueue is the Michael–Scott queue. Dequeued nodes are never reused, only dropped for the garbage
+// collector, so a pointer observed by a slow goroutine cannot be recycled underneath it and CAS is ABA safe.
+type LockFreeQueue struct {
+	head unsafe.Pointer
+	tail unsafe.Pointer
+	size int64
+}
+
+type lockFreeNode struct {
+	data int
+	next unsafe.Pointer
+}
+
+func NewLockFreeQueue() *LockFreeQueue {
+	dummy := unsafe.Pointer(&lockFreeNode{})
+	return &LockFreeQueue{
+		head: dummy,
+		tail: dummy,
+	}
+}
+
+func (lq *LockFreeQueue) Clear() {
+	for {
+		if _, ok := lq.TryDequeue(); !ok {
+			return
+		}
+	}
+}
+
+func (lq *LockFreeQueue) Dequeue() int {
+	e, ok := lq.TryDequeue()
+	if !ok {
+		panic("queue is empty")
+	}
+	return e
+}
+
+func (lq *LockFreeQueue) Empty() bool {
+	return loadNode(&loadNode(&lq.head).next) == nil
+}
+
+func (lq *LockFreeQueue) Enqueue(element int) bool {
+	n := &lockFreeNode{data: element}
+
+	for {
+		tail := loadNode(&lq.tail)
+		next := loadNode(&tail.next)
+		if tail != loadNode(&lq.tail) {
+			continue
+		}
+
+		if next != nil {
+			// another enqueue linked its node but has not swung the tail yet, help it along
+			casNode(&lq.tail, tail, next)
+			continue
+		}
+
+		if casNode(&tail.next, nil, n) {
+			casNode(&lq.tail, tail, n)
+			atomic.AddInt64(&lq.size, 1)
+			return true
+		}
+	}
+}
+
+func (lq *LockFreeQueue) Peek() int {
+	e, ok := lq.TryPeek()
+	if !ok {
+		panic("queue is empty")
+	}
+	return e
+}
+
+// Size is exact when the queue is quiescent and approximate while operations are in flight.
+func (lq *LockFreeQueue) Size() int {
+	size := atomic.LoadInt64(&lq.size)
+	if size < 0 {
+		return 0
+	}
+	return int(size)
+}
+
+func (lq *LockFreeQueue) TryDequeue() (int, bool) {
+	for {
+		head := loadNode(&lq.head)
+		tail := loadNode(&lq.tail)
+		next := loadNode(&head.next)
+		if head != loadNode(&lq.head) {
+			continue
+		}
+
+		if head == tail {
+			if next == nil {
+				return -1, false
+			}
+			casNode(&lq.tail, tail, next)
+			continue
+		}
+
+		e := next.data
+		if casNode(&lq.head, head, next) {
+			atomic.AddInt64(&lq.size, -1)
+			return e, true
+		}
+	}
+}
+
+func (lq *LockFreeQueue) TryPeek() (int, bool) {
+	next := loadNode(&loadNode(&lq.head).next)
+	if next == nil {
+		return -1, false
+	}
+	return next.data, true
+}
+
+//Helper Functions
+func loadNode(p *unsafe.Pointer) *lockFreeNode {
+	return (*lockFreeNode)(atomic.LoadPointer(p))
+}
+
+func casNode(p *unsafe.Pointer, old, new *lockFreeNode) bool {
+	return atomic.CompareAndSwapPointer(p, unsafe.Pointer(old), unsafe.Pointer(new))
+}
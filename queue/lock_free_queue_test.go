@@ -0,0 +1,203 @@
+package queue
+
+import (
+	"github.com/stretchr/testify/assert"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+func TestLockFreeQueue(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func() []interface{}
+		expectedResult []interface{}
+	}{
+		{
+			name: "test lock free queue on new queue",
+			actualResult: func() []interface{} {
+				q := NewLockFreeQueue()
+				e, ok := q.TryDequeue()
+				p, pok := q.TryPeek()
+				return []interface{}{q.Empty(), q.Size(), e, ok, p, pok}
+			},
+			expectedResult: []interface{}{true, 0, -1, false, -1, false},
+		},
+		{
+			name: "test lock free queue keeps fifo order",
+			actualResult: func() []interface{} {
+				q := NewLockFreeQueue()
+				q.Enqueue(1)
+				q.Enqueue(2)
+				q.Enqueue(3)
+				return []interface{}{q.Peek(), q.Dequeue(), q.Dequeue(), q.Size(), q.Empty()}
+			},
+			expectedResult: []interface{}{1, 1, 2, 1, false},
+		},
+		{
+			name: "test lock free queue clear",
+			actualResult: func() []interface{} {
+				q := NewLockFreeQueue()
+				q.Enqueue(1)
+				q.Enqueue(2)
+				q.Clear()
+				q.Enqueue(3)
+				return []interface{}{q.Size(), q.Dequeue(), q.Empty()}
+			},
+			expectedResult: []interface{}{1, 3, true},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := testCase.actualResult()
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func TestLockFreeQueuePanicsOnEmpty(t *testing.T) {
+	testCases := []struct {
+		name         string
+		actualResult func(q Queue) int
+	}{
+		{
+			name: "test dequeue on empty lock free queue",
+			actualResult: func(q Queue) int {
+				return q.Dequeue()
+			},
+		},
+		{
+			name: "test peek on empty lock free queue",
+			actualResult: func(q Queue) int {
+				return q.Peek()
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("didn't panic on empty lock free queue")
+				}
+			}()
+			testCase.actualResult(NewLockFreeQueue())
+		})
+	}
+}
+
+// every value must be delivered exactly once, and a consumer must see each producer's values in the order
+// they were enqueued; any linearizable FIFO queue satisfies both
+func TestLockFreeQueueConcurrentProducersConsumers(t *testing.T) {
+	const producers, consumers, perProducer = 4, 4, 5000
+
+	q := NewLockFreeQueue()
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				q.Enqueue(p*perProducer + i)
+			}
+		}(p)
+	}
+
+	results := make([][]int, consumers)
+	var remaining int64 = producers * perProducer
+	var mu sync.Mutex
+	var cwg sync.WaitGroup
+	for c := 0; c < consumers; c++ {
+		cwg.Add(1)
+		go func(c int) {
+			defer cwg.Done()
+			for {
+				mu.Lock()
+				done := remaining == 0
+				mu.Unlock()
+				if done {
+					return
+				}
+
+				e, ok := q.TryDequeue()
+				if !ok {
+					runtime.Gosched()
+					continue
+				}
+
+				results[c] = append(results[c], e)
+				mu.Lock()
+				remaining--
+				mu.Unlock()
+			}
+		}(c)
+	}
+
+	wg.Wait()
+	cwg.Wait()
+
+	seen := make([]bool, producers*perProducer)
+	for _, res := range results {
+		last := make([]int, producers)
+		for i := range last {
+			last[i] = -1
+		}
+
+		for _, e := range res {
+			assert.False(t, seen[e], "value %d dequeued twice", e)
+			seen[e] = true
+
+			p, i := e/perProducer, e%perProducer
+			assert.Greater(t, i, last[p], "producer %d values dequeued out of order", p)
+			last[p] = i
+		}
+	}
+	for e, ok := range seen {
+		assert.True(t, ok, "value %d was lost", e)
+	}
+	assert.True(t, q.Empty())
+	assert.Equal(t, 0, q.Size())
+}
+
+type testLockedQueue struct {
+	mu sync.Mutex
+	q  Queue
+}
+
+func (lq *testLockedQueue) Enqueue(element int) bool {
+	lq.mu.Lock()
+	defer lq.mu.Unlock()
+	return lq.q.Enqueue(element)
+}
+
+func (lq *testLockedQueue) TryDequeue() (int, bool) {
+	lq.mu.Lock()
+	defer lq.mu.Unlock()
+	if lq.q.Empty() {
+		return -1, false
+	}
+	return lq.q.Dequeue(), true
+}
+
+func benchmarkConcurrentQueue(b *testing.B, enqueue func(int) bool, tryDequeue func() (int, bool)) {
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if i%2 == 0 {
+				enqueue(i)
+			} else {
+				tryDequeue()
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkLockFreeQueue(b *testing.B) {
+	q := NewLockFreeQueue()
+	benchmarkConcurrentQueue(b, q.Enqueue, q.TryDequeue)
+}
+
+func BenchmarkLockedLinkedListQueue(b *testing.B) {
+	q := &testLockedQueue{q: NewLinkedListQueue()}
+	benchmarkConcurrentQueue(b, q.Enqueue, q.TryDequeue)
+}
@@ -0,0 +1,225 @@
+package queue
+
+import (
+	"github.com/rewantsoni/go-datastructures/list"
+	"math/bits"
+)
+
+type EvictionPolicy int
+
+const (
+	// EvictMin keeps the largest elements, as for a bounded top-K.
+	EvictMin EvictionPolicy = iota
+	// EvictMax keeps the smallest elements.
+	EvictMax
+)
+
+// MinMaxHeap is a double-ended priority queue: nodes on even levels are no larger than their descendants
+// and nodes on odd levels no smaller, so the minimum is the root and the maximum one of its children.
+type MinMaxHeap struct {
+	capacity int
+	evict    EvictionPolicy
+	data     []int
+}
+
+func NewMinMaxHeap(elements ...int) *MinMaxHeap {
+	mmh := &MinMaxHeap{
+		data: make([]int, len(elements), initialCapacity+len(elements)),
+	}
+
+	copy(mmh.data, elements)
+	mmh.heapify()
+
+	return mmh
+}
+
+// NewBoundedMinMaxHeap holds at most capacity elements; pushing onto a full heap gives up the extreme
+// chosen by evict, which is the new element itself if that is the one to go.
+func NewBoundedMinMaxHeap(capacity int, evict EvictionPolicy) *MinMaxHeap {
+	if capacity <= 0 {
+		return nil
+	}
+
+	return &MinMaxHeap{
+		capacity: capacity,
+		evict:    evict,
+		data:     make([]int, nought, capacity),
+	}
+}
+
+func NewMinMaxHeapFromList(l list.List) *MinMaxHeap {
+	elements := make([]int, 0, l.Size())
+	for it := l.Iterator(); it.HasNext(); {
+		elements = append(elements, it.Next())
+	}
+
+	return NewMinMaxHeap(elements...)
+}
+
+// Capacity is zero for an unbounded heap.
+func (mmh *MinMaxHeap) Capacity() int {
+	return mmh.capacity
+}
+
+func (mmh *MinMaxHeap) Clear() {
+	mmh.data = mmh.data[:0]
+}
+
+func (mmh *MinMaxHeap) Empty() bool {
+	return mmh.Size() == 0
+}
+
+func (mmh *MinMaxHeap) PeekMax() int {
+	return mmh.data[mmh.maxIndex()]
+}
+
+func (mmh *MinMaxHeap) PeekMin() int {
+	mmh.checkNotEmpty()
+	return mmh.data[0]
+}
+
+func (mmh *MinMaxHeap) PopMax() int {
+	return mmh.removeAt(mmh.maxIndex())
+}
+
+func (mmh *MinMaxHeap) PopMin() int {
+	mmh.checkNotEmpty()
+	return mmh.removeAt(0)
+}
+
+// Push returns false if the heap is bounded, full, and the new element is the one that would be evicted.
+func (mmh *MinMaxHeap) Push(element int) bool {
+	if mmh.capacity > 0 && mmh.Size() >= mmh.capacity {
+		if mmh.evict == EvictMin {
+			if element <= mmh.PeekMin() {
+				return false
+			}
+			mmh.PopMin()
+		} else {
+			if element >= mmh.PeekMax() {
+				return false
+			}
+			mmh.PopMax()
+		}
+	}
+
+	mmh.data = append(mmh.data, element)
+	mmh.pushUp(mmh.Size() - 1)
+	return true
+}
+
+func (mmh *MinMaxHeap) Size() int {
+	return len(mmh.data)
+}
+
+//Helper Functions
+func (mmh *MinMaxHeap) checkNotEmpty() {
+	if mmh.Empty() {
+		panic("heap is empty")
+	}
+}
+
+func (mmh *MinMaxHeap) maxIndex() int {
+	mmh.checkNotEmpty()
+
+	switch {
+	case mmh.Size() == 1:
+		return 0
+	case mmh.Size() == 2 || mmh.data[1] >= mmh.data[2]:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func (mmh *MinMaxHeap) removeAt(index int) int {
+	e := mmh.data[index]
+	last := mmh.Size() - 1
+
+	mmh.data[index] = mmh.data[last]
+	mmh.data = mmh.data[:last]
+
+	if index < last {
+		mmh.pushDown(index)
+	}
+	return e
+}
+
+func (mmh *MinMaxHeap) heapify() {
+	for i := mmh.Size()/2 - 1; i >= 0; i-- {
+		mmh.pushDown(i)
+	}
+}
+
+// minMaxBefore reports whether a belongs above b on a min level, or on a max level when onMinLevel is false.
+func minMaxBefore(a, b int, onMinLevel bool) bool {
+	if onMinLevel {
+		return a < b
+	}
+	return a > b
+}
+
+func isMinLevel(index int) bool {
+	return (bits.Len(uint(index+1))-1)%2 == 0
+}
+
+func (mmh *MinMaxHeap) pushUp(index int) {
+	if index == 0 {
+		return
+	}
+
+	minLevel := isMinLevel(index)
+	parent := (index - 1) / 2
+	if minMaxBefore(mmh.data[parent], mmh.data[index], minLevel) {
+		mmh.data[index], mmh.data[parent] = mmh.data[parent], mmh.data[index]
+		mmh.pushUpGrandparents(parent, !minLevel)
+		return
+	}
+	mmh.pushUpGrandparents(index, minLevel)
+}
+
+func (mmh *MinMaxHeap) pushUpGrandparents(index int, minLevel bool) {
+	for index > 2 {
+		grandparent := ((index-1)/2 - 1) / 2
+		if !minMaxBefore(mmh.data[index], mmh.data[grandparent], minLevel) {
+			return
+		}
+
+		mmh.data[index], mmh.data[grandparent] = mmh.data[grandparent], mmh.data[index]
+		index = grandparent
+	}
+}
+
+func (mmh *MinMaxHeap) pushDown(index int) {
+	minLevel := isMinLevel(index)
+
+	for {
+		first := 2*index + 1
+		if first >= mmh.Size() {
+			return
+		}
+
+		// the most extreme of the up to two children and four grandchildren
+		m := first
+		for _, c := range []int{first + 1, 2*first + 1, 2*first + 2, 2*first + 3, 2*first + 4} {
+			if c < mmh.Size() && minMaxBefore(mmh.data[c], mmh.data[m], minLevel) {
+				m = c
+			}
+		}
+
+		if !minMaxBefore(mmh.data[m], mmh.data[index], minLevel) {
+			return
+		}
+		mmh.data[m], mmh.data[index] = mmh.data[index], mmh.data[m]
+
+		if m <= first+1 {
+			return
+		}
+
+		parent := (m - 1) / 2
+		if minMaxBefore(mmh.data[parent], mmh.data[m], minLevel) {
+			mmh.data[m], mmh.data[parent] = mmh.data[parent], mmh.data[m]
+		}
+		index = m
+	}
+}
@@ -0,0 +1,189 @@
+package queue
+
+import (
+	"github.com/rewantsoni/go-datastructures/list"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestMinMaxHeapPeekAndPop(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func() *MinMaxHeap
+		expectedResult []int
+	}{
+		{
+			name: "test min max heap from elements",
+			actualResult: func() *MinMaxHeap {
+				return NewMinMaxHeap(5, 3, 8, 1, 9, 2, 7)
+			},
+			expectedResult: []int{1, 2, 3, 5, 7, 8, 9},
+		},
+		{
+			name: "test min max heap from list",
+			actualResult: func() *MinMaxHeap {
+				return NewMinMaxHeapFromList(list.NewLinkedList(4, 4, 1, 6, 2))
+			},
+			expectedResult: []int{1, 2, 4, 4, 6},
+		},
+		{
+			name: "test min max heap through push",
+			actualResult: func() *MinMaxHeap {
+				mmh := NewMinMaxHeap()
+				for _, e := range []int{10, 20, 5, 15, 1, 30, 25} {
+					mmh.Push(e)
+				}
+				return mmh
+			},
+			expectedResult: []int{1, 5, 10, 15, 20, 25, 30},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mmh := testCase.actualResult()
+			testCheckMinMaxHeap(t, mmh)
+
+			var mins, maxes []int
+			for !mmh.Empty() {
+				assert.Equal(t, mmh.PeekMin(), mmh.data[0])
+				mins = append(mins, mmh.PopMin())
+				if !mmh.Empty() {
+					max := mmh.PeekMax()
+					assert.Equal(t, max, mmh.PopMax())
+					maxes = append([]int{max}, maxes...)
+				}
+				testCheckMinMaxHeap(t, mmh)
+			}
+			assert.Equal(t, testCase.expectedResult, append(mins, maxes...))
+		})
+	}
+}
+
+func TestMinMaxHeapBounded(t *testing.T) {
+	testCases := []struct {
+		name            string
+		evict           EvictionPolicy
+		pushes          []int
+		expectedPushed  []bool
+		expectedContent []int
+	}{
+		{
+			name:            "test bounded heap keeps the largest",
+			evict:           EvictMin,
+			pushes:          []int{5, 1, 9, 3, 7, 2},
+			expectedPushed:  []bool{true, true, true, true, true, false},
+			expectedContent: []int{5, 7, 9},
+		},
+		{
+			name:            "test bounded heap keeps the smallest",
+			evict:           EvictMax,
+			pushes:          []int{5, 1, 9, 3, 7, 2},
+			expectedPushed:  []bool{true, true, true, true, false, true},
+			expectedContent: []int{1, 2, 3},
+		},
+		{
+			name:            "test bounded heap rejects ties with the evicted extreme",
+			evict:           EvictMin,
+			pushes:          []int{1, 2, 3, 1},
+			expectedPushed:  []bool{true, true, true, false},
+			expectedContent: []int{1, 2, 3},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mmh := NewBoundedMinMaxHeap(3, testCase.evict)
+			var pushed []bool
+			for _, e := range testCase.pushes {
+				pushed = append(pushed, mmh.Push(e))
+			}
+
+			assert.Equal(t, testCase.expectedPushed, pushed)
+			assert.Equal(t, 3, mmh.Capacity())
+
+			var res []int
+			for !mmh.Empty() {
+				res = append(res, mmh.PopMin())
+			}
+			assert.Equal(t, testCase.expectedContent, res)
+		})
+	}
+}
+
+func TestMinMaxHeapPanicsOnEmpty(t *testing.T) {
+	testCases := []struct {
+		name         string
+		actualResult func(mmh *MinMaxHeap) int
+	}{
+		{
+			name:         "test peek min on empty heap",
+			actualResult: (*MinMaxHeap).PeekMin,
+		},
+		{
+			name:         "test peek max on empty heap",
+			actualResult: (*MinMaxHeap).PeekMax,
+		},
+		{
+			name:         "test pop min on empty heap",
+			actualResult: (*MinMaxHeap).PopMin,
+		},
+		{
+			name:         "test pop max on empty heap",
+			actualResult: (*MinMaxHeap).PopMax,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("didn't panic on empty heap")
+				}
+			}()
+			testCase.actualResult(NewMinMaxHeap())
+		})
+	}
+}
+
+func TestMinMaxHeapRandomised(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	mmh := NewMinMaxHeap()
+	var expected []int
+
+	for i := 0; i < 3000; i++ {
+		switch op := r.Intn(4); {
+		case op < 2 || len(expected) == 0:
+			e := r.Intn(200)
+			mmh.Push(e)
+			expected = append(expected, e)
+		case op == 2:
+			sort.Ints(expected)
+			assert.Equal(t, expected[0], mmh.PopMin())
+			expected = expected[1:]
+		default:
+			sort.Ints(expected)
+			assert.Equal(t, expected[len(expected)-1], mmh.PopMax())
+			expected = expected[:len(expected)-1]
+		}
+		assert.Equal(t, len(expected), mmh.Size())
+	}
+	testCheckMinMaxHeap(t, mmh)
+
+	mmh.Clear()
+	assert.True(t, mmh.Empty())
+}
+
+func testCheckMinMaxHeap(t *testing.T, mmh *MinMaxHeap) {
+	for i := range mmh.data {
+		for a := (i - 1) / 2; i > 0 && a >= 0; a = (a - 1) / 2 {
+			if isMinLevel(a) {
+				assert.LessOrEqual(t, mmh.data[a], mmh.data[i])
+			} else {
+				assert.GreaterOrEqual(t, mmh.data[a], mmh.data[i])
+			}
+			if a == 0 {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package queue
+
+import "github.com/rewantsoni/go-datastructures/operators"
+
+// PairingHeap is a heap-ordered multiway tree. Insert, Merge and DecreaseKey just link two trees in O(1);
+// DeleteMin re-pairs the root's children and costs O(log n) amortised.
+type PairingHeap struct {
+	comparator operators.Comparator
+	root       *PairingNode
+	size       int
+	owner      *pairingOwner
+}
+
+// PairingNode is a handle to an element of a PairingHeap, valid until that element is deleted or the heap
+// holding it is cleared.
+type PairingNode struct {
+	value   int
+	child   *PairingNode
+	sibling *PairingNode
+	// the parent for a leftmost child, the left sibling otherwise
+	prev    *PairingNode
+	removed bool
+	owner   *pairingOwner
+}
+
+// pairingOwner identifies the heap a node belongs to. A heap swaps in a fresh owner when it is cleared,
+// which orphans every outstanding handle, and when it is merged away, after forwarding its old owner to the
+// heap it merged into so that handles follow their elements without Merge touching each node.
+type pairingOwner struct {
+	mergedInto *pairingOwner
+}
+
+func NewPairingHeap(comparator operators.Comparator) *PairingHeap {
+	if comparator == nil {
+		comparator = operators.NaturalOrder{}
+	}
+
+	return &PairingHeap{
+		comparator: comparator,
+		owner:      &pairingOwner{},
+	}
+}
+
+func (pn *PairingNode) Value() int {
+	return pn.value
+}
+
+// Clear invalidates every handle into the heap.
+func (ph *PairingHeap) Clear() {
+	ph.root = nil
+	ph.size = nought
+	ph.owner = &pairingOwner{}
+}
+
+// DecreaseKey moves node towards the front of the heap by giving it value, and returns false if value
+// would move it backwards or the node is not in this heap, because it was deleted, cleared away or belongs
+// to another heap.
+func (ph *PairingHeap) DecreaseKey(node *PairingNode, value int) bool {
+	if node == nil || node.removed || node.heapOwner() != ph.owner || ph.comparator.Compare(value, node.value) > 0 {
+		return false
+	}
+
+	node.value = value
+	if node == ph.root {
+		return true
+	}
+
+	node.cut()
+	ph.root = ph.link(ph.root, node)
+	return true
+}
+
+func (ph *PairingHeap) DeleteMin() int {
+	if ph.Empty() {
+		panic("heap is empty")
+	}
+
+	root := ph.root
+	ph.root = ph.pair(root.child)
+	if ph.root != nil {
+		ph.root.prev = nil
+	}
+	ph.size--
+
+	root.child = nil
+	root.removed = true
+	return root.value
+}
+
+func (ph *PairingHeap) Dequeue() int {
+	return ph.DeleteMin()
+}
+
+func (ph *PairingHeap) Empty() bool {
+	return ph.Size() == 0
+}
+
+func (ph *PairingHeap) Enqueue(element int) bool {
+	ph.Insert(element)
+	return true
+}
+
+func (ph *PairingHeap) FindMin() int {
+	if ph.Empty() {
+		panic("heap is empty")
+	}
+	return ph.root.value
+}
+
+func (ph *PairingHeap) Insert(element int) *PairingNode {
+	node := &PairingNode{value: element, owner: ph.owner}
+	ph.root = ph.link(ph.root, node)
+	ph.size++
+	return node
+}
+
+// Merge moves every element of other into ph in O(1) and leaves other empty. Handles into other stay valid
+// and now refer to ph. Both heaps are expected to use the same ordering.
+func (ph *PairingHeap) Merge(other *PairingHeap) {
+	if other == nil || other == ph {
+		return
+	}
+
+	ph.root = ph.link(ph.root, other.root)
+	ph.size += other.size
+	other.owner.mergedInto = ph.owner
+	other.Clear()
+}
+
+func (ph *PairingHeap) Peek() int {
+	return ph.FindMin()
+}
+
+func (ph *PairingHeap) Size() int {
+	return ph.size
+}
+
+//Helper Functions
+func (ph *PairingHeap) link(a, b *PairingNode) *PairingNode {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+
+	if ph.comparator.Compare(b.value, a.value) < 0 {
+		a, b = b, a
+	}
+
+	b.prev = a
+	b.sibling = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	a.child = b
+	a.sibling = nil
+	a.prev = nil
+
+	return a
+}
+
+// pair merges a list of siblings left to right in pairs, then folds the pairs right to left.
+func (ph *PairingHeap) pair(first *PairingNode) *PairingNode {
+	var pairs []*PairingNode
+	for first != nil {
+		a := first
+		b := a.sibling
+		if b == nil {
+			a.sibling = nil
+			pairs = append(pairs, a)
+			break
+		}
+
+		first = b.sibling
+		a.sibling, b.sibling = nil, nil
+		pairs = append(pairs, ph.link(a, b))
+	}
+
+	var root *PairingNode
+	for i := len(pairs) - 1; i >= 0; i-- {
+		root = ph.link(pairs[i], root)
+	}
+	return root
+}
+
+// heapOwner follows the merges since the node was inserted, shortening the chain for the next lookup.
+func (pn *PairingNode) heapOwner() *pairingOwner {
+	for pn.owner.mergedInto != nil {
+		pn.owner = pn.owner.mergedInto
+	}
+	return pn.owner
+}
+
+func (pn *PairingNode) cut() {
+	if pn.prev.child == pn {
+		pn.prev.child = pn.sibling
+	} else {
+		pn.prev.sibling = pn.sibling
+	}
+
+	if pn.sibling != nil {
+		pn.sibling.prev = pn.prev
+	}
+
+	pn.prev = nil
+	pn.sibling = nil
+}
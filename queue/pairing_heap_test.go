@@ -0,0 +1,350 @@
+package queue
+
+import (
+	"github.com/rewantsoni/go-datastructures/operators"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestPairingHeapOrder(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func() *PairingHeap
+		expectedResult []int
+	}{
+		{
+			name: "test min pairing heap",
+			actualResult: func() *PairingHeap {
+				ph := NewPairingHeap(nil)
+				for _, e := range []int{5, 3, 8, 1, 9, 2} {
+					ph.Insert(e)
+				}
+				return ph
+			},
+			expectedResult: []int{1, 2, 3, 5, 8, 9},
+		},
+		{
+			name: "test max pairing heap",
+			actualResult: func() *PairingHeap {
+				ph := NewPairingHeap(operators.ReverseOrder{})
+				for _, e := range []int{5, 3, 8, 1, 9, 2} {
+					ph.Enqueue(e)
+				}
+				return ph
+			},
+			expectedResult: []int{9, 8, 5, 3, 2, 1},
+		},
+		{
+			name: "test merge pairing heaps",
+			actualResult: func() *PairingHeap {
+				a, b := NewPairingHeap(nil), NewPairingHeap(nil)
+				for _, e := range []int{4, 1, 7} {
+					a.Insert(e)
+				}
+				for _, e := range []int{3, 6, 0} {
+					b.Insert(e)
+				}
+				a.Merge(b)
+				if !b.Empty() {
+					return nil
+				}
+				return a
+			},
+			expectedResult: []int{0, 1, 3, 4, 6, 7},
+		},
+		{
+			name: "test merge with empty pairing heap",
+			actualResult: func() *PairingHeap {
+				a := NewPairingHeap(nil)
+				b := NewPairingHeap(nil)
+				b.Insert(1)
+				a.Merge(b)
+				a.Merge(NewPairingHeap(nil))
+				a.Merge(a)
+				return a
+			},
+			expectedResult: []int{1},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ph := testCase.actualResult()
+			assert.Equal(t, len(testCase.expectedResult), ph.Size())
+
+			var res []int
+			for !ph.Empty() {
+				assert.Equal(t, ph.Peek(), ph.FindMin())
+				res = append(res, ph.Dequeue())
+			}
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func TestPairingHeapDecreaseKey(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func(ph *PairingHeap, nodes []*PairingNode) bool
+		expectedResult bool
+		expectedOrder  []int
+	}{
+		{
+			name: "test decrease key of a leaf to the front",
+			actualResult: func(ph *PairingHeap, nodes []*PairingNode) bool {
+				return ph.DecreaseKey(nodes[4], 0)
+			},
+			expectedResult: true,
+			expectedOrder:  []int{0, 10, 20, 30, 40},
+		},
+		{
+			name: "test decrease key of the root",
+			actualResult: func(ph *PairingHeap, nodes []*PairingNode) bool {
+				return ph.DecreaseKey(nodes[0], 5)
+			},
+			expectedResult: true,
+			expectedOrder:  []int{5, 20, 30, 40, 50},
+		},
+		{
+			name: "test decrease key within the heap",
+			actualResult: func(ph *PairingHeap, nodes []*PairingNode) bool {
+				return ph.DecreaseKey(nodes[3], 25)
+			},
+			expectedResult: true,
+			expectedOrder:  []int{10, 20, 25, 30, 50},
+		},
+		{
+			name: "test decrease key with a larger value",
+			actualResult: func(ph *PairingHeap, nodes []*PairingNode) bool {
+				return ph.DecreaseKey(nodes[1], 60)
+			},
+			expectedResult: false,
+			expectedOrder:  []int{10, 20, 30, 40, 50},
+		},
+		{
+			name: "test decrease key of a deleted node",
+			actualResult: func(ph *PairingHeap, nodes []*PairingNode) bool {
+				ph.DeleteMin()
+				ok := ph.DecreaseKey(nodes[0], 0)
+				ph.Insert(10)
+				return ok
+			},
+			expectedResult: false,
+			expectedOrder:  []int{10, 20, 30, 40, 50},
+		},
+		{
+			name: "test decrease key of nodes cleared from the heap",
+			actualResult: func(ph *PairingHeap, nodes []*PairingNode) bool {
+				ph.Clear()
+				root := ph.DecreaseKey(nodes[0], 0)
+				nonRoot := ph.DecreaseKey(nodes[3], 0)
+				ph.Insert(7)
+				return root || nonRoot || ph.Size() != 1
+			},
+			expectedResult: false,
+			expectedOrder:  []int{7},
+		},
+		{
+			name: "test decrease key of a node from another heap",
+			actualResult: func(ph *PairingHeap, nodes []*PairingNode) bool {
+				other := NewPairingHeap(nil)
+				other.Insert(1)
+				foreign := other.Insert(5)
+				return ph.DecreaseKey(foreign, 0) || other.Size() != 2 || other.FindMin() != 1
+			},
+			expectedResult: false,
+			expectedOrder:  []int{10, 20, 30, 40, 50},
+		},
+		{
+			name: "test decrease key after a delete min restructured the heap",
+			actualResult: func(ph *PairingHeap, nodes []*PairingNode) bool {
+				ph.DeleteMin()
+				ph.Insert(10)
+				return ph.DecreaseKey(nodes[4], 15)
+			},
+			expectedResult: true,
+			expectedOrder:  []int{10, 15, 20, 30, 40},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ph := NewPairingHeap(nil)
+			var nodes []*PairingNode
+			for _, e := range []int{10, 20, 30, 40, 50} {
+				nodes = append(nodes, ph.Insert(e))
+			}
+
+			assert.Equal(t, testCase.expectedResult, testCase.actualResult(ph, nodes))
+
+			var res []int
+			for !ph.Empty() {
+				res = append(res, ph.DeleteMin())
+			}
+			assert.Equal(t, testCase.expectedOrder, res)
+		})
+	}
+}
+
+func TestPairingHeapHandlesFollowMerge(t *testing.T) {
+	a, b, c := NewPairingHeap(nil), NewPairingHeap(nil), NewPairingHeap(nil)
+	a.Insert(10)
+	nodeOfB := b.Insert(20)
+	nodeOfC := c.Insert(30)
+
+	b.Merge(c)
+	a.Merge(b)
+	assert.False(t, b.DecreaseKey(nodeOfB, 0), "a merged away heap must not accept its old handles")
+	assert.False(t, c.DecreaseKey(nodeOfC, 0), "a merged away heap must not accept its old handles")
+	assert.True(t, a.DecreaseKey(nodeOfC, 5))
+	assert.True(t, a.DecreaseKey(nodeOfB, 1))
+
+	var res []int
+	for !a.Empty() {
+		res = append(res, a.DeleteMin())
+	}
+	assert.Equal(t, []int{1, 5, 10}, res)
+	assert.Equal(t, []int{0, 0}, []int{b.Size(), c.Size()})
+
+	b.Insert(40)
+	assert.False(t, b.DecreaseKey(nodeOfB, 0), "a deleted node must stay invalid")
+}
+
+func TestPairingHeapPanicsOnEmpty(t *testing.T) {
+	testCases := []struct {
+		name         string
+		actualResult func(ph *PairingHeap) int
+	}{
+		{
+			name:         "test find min on empty pairing heap",
+			actualResult: (*PairingHeap).FindMin,
+		},
+		{
+			name:         "test delete min on empty pairing heap",
+			actualResult: (*PairingHeap).DeleteMin,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("didn't panic on empty pairing heap")
+				}
+			}()
+			testCase.actualResult(NewPairingHeap(nil))
+		})
+	}
+}
+
+func TestPairingHeapRandomised(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	ph := NewPairingHeap(nil)
+	live := map[*PairingNode]bool{}
+
+	for i := 0; i < 5000; i++ {
+		switch op := r.Intn(5); {
+		case op < 2 || len(live) == 0:
+			live[ph.Insert(r.Intn(1000))] = true
+		case op == 2:
+			for node := range live {
+				if ph.DecreaseKey(node, node.Value()-r.Intn(50)) {
+					break
+				}
+			}
+		case op == 3:
+			other := NewPairingHeap(nil)
+			for j := 0; j < 3; j++ {
+				live[other.Insert(r.Intn(1000))] = true
+			}
+			ph.Merge(other)
+		default:
+			var values []int
+			for node := range live {
+				values = append(values, node.Value())
+			}
+			sort.Ints(values)
+
+			min := ph.DeleteMin()
+			assert.Equal(t, values[0], min)
+			for node := range live {
+				if node.removed {
+					delete(live, node)
+				}
+			}
+		}
+		assert.Equal(t, len(live), ph.Size())
+	}
+
+	ph.Clear()
+	assert.True(t, ph.Empty())
+}
+
+func BenchmarkPairingHeapInsertDeleteMin(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	ph := NewPairingHeap(nil)
+	for i := 0; i < 1000; i++ {
+		ph.Insert(r.Int())
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ph.Insert(r.Int())
+		ph.DeleteMin()
+	}
+}
+
+func BenchmarkPriorityQueueInsertDeleteMin(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	pq := NewPriorityQueue(nil)
+	for i := 0; i < 1000; i++ {
+		pq.Enqueue(r.Int())
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pq.Enqueue(r.Int())
+		pq.Dequeue()
+	}
+}
+
+func BenchmarkPairingHeapMerge(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		shards := make([]*PairingHeap, 8)
+		for s := range shards {
+			shards[s] = NewPairingHeap(nil)
+			for j := 0; j < 1000; j++ {
+				shards[s].Insert(j)
+			}
+		}
+		b.StartTimer()
+
+		merged := NewPairingHeap(nil)
+		for _, shard := range shards {
+			merged.Merge(shard)
+		}
+		merged.DeleteMin()
+	}
+}
+
+func BenchmarkPriorityQueueMerge(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		shards := make([]*PriorityQueue, 8)
+		for s := range shards {
+			shards[s] = NewPriorityQueue(nil)
+			for j := 0; j < 1000; j++ {
+				shards[s].Enqueue(j)
+			}
+		}
+		b.StartTimer()
+
+		merged := NewPriorityQueue(nil)
+		for _, shard := range shards {
+			for it := shard.Iterator(); it.HasNext(); {
+				merged.Enqueue(it.Next())
+			}
+		}
+		merged.Dequeue()
+	}
+}
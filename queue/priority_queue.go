@@ -0,0 +1,218 @@
+package queue
+
+import (
+	"fmt"
+	"github.com/rewantsoni/go-datastructures/iterator"
+	"github.com/rewantsoni/go-datastructures/list"
+	"github.com/rewantsoni/go-datastructures/operators"
+	"strings"
+)
+
+// PriorityQueue is a binary heap; the element the comparator orders first is at the head of the queue, so
+// operators.NaturalOrder gives a min-queue and operators.ReverseOrder a max-queue.
+type PriorityQueue struct {
+	comparator operators.Comparator
+	data       []int
+}
+
+type priorityQueueIterator struct {
+	currentIndex int
+	pq           *PriorityQueue
+}
+
+func NewPriorityQueue(comparator operators.Comparator, elements ...int) *PriorityQueue {
+	if comparator == nil {
+		comparator = operators.NaturalOrder{}
+	}
+
+	pq := &PriorityQueue{
+		comparator: comparator,
+		data:       make([]int, len(elements), initialCapacity+len(elements)),
+	}
+
+	copy(pq.data, elements)
+	pq.heapify()
+
+	return pq
+}
+
+func Heapify(l list.List, comparator operators.Comparator) *PriorityQueue {
+	elements := make([]int, 0, l.Size())
+	for it := l.Iterator(); it.HasNext(); {
+		elements = append(elements, it.Next())
+	}
+
+	return NewPriorityQueue(comparator, elements...)
+}
+
+func (pq *PriorityQueue) Clear() {
+	pq.data = make([]int, nought, initialCapacity)
+}
+
+func (pq *PriorityQueue) Contains(element int) bool {
+	return pq.find(element) != -1
+}
+
+func (pq *PriorityQueue) Dequeue() int {
+	if pq.Empty() {
+		panic("queue is empty")
+	}
+	return pq.removeAt(0)
+}
+
+func (pq *PriorityQueue) Empty() bool {
+	return pq.Size() == 0
+}
+
+func (pq *PriorityQueue) Enqueue(element int) bool {
+	pq.data = append(pq.data, element)
+	pq.siftUp(pq.Size() - 1)
+	return true
+}
+
+// Iterator visits every element once, in heap order rather than priority order.
+func (pq *PriorityQueue) Iterator() iterator.Iterator {
+	return newPriorityQueueIterator(pq)
+}
+
+func (pq *PriorityQueue) Peek() int {
+	if pq.Empty() {
+		panic("queue is empty")
+	}
+	return pq.data[0]
+}
+
+func (pq *PriorityQueue) Remove(element int) bool {
+	index := pq.find(element)
+	if index == -1 {
+		return false
+	}
+
+	pq.removeAt(index)
+	return true
+}
+
+func (pq *PriorityQueue) Size() int {
+	return len(pq.data)
+}
+
+func (pq *PriorityQueue) String() string {
+	sb := strings.Builder{}
+
+	for _, e := range pq.data {
+		sb.WriteString(fmt.Sprintf("%d ", e))
+	}
+
+	return sb.String()
+}
+
+// Update replaces one occurrence of oldElement with newElement and restores the heap order around it.
+func (pq *PriorityQueue) Update(oldElement int, newElement int) bool {
+	index := pq.find(oldElement)
+	if index == -1 {
+		return false
+	}
+
+	pq.data[index] = newElement
+	pq.fix(index)
+	return true
+}
+
+func (pqi *priorityQueueIterator) HasNext() bool {
+	return pqi.currentIndex < pqi.pq.Size()
+}
+
+func (pqi *priorityQueueIterator) Next() int {
+	if !pqi.HasNext() {
+		panic("panic: priority queue iterator is exhausted")
+	}
+
+	e := pqi.pq.data[pqi.currentIndex]
+	pqi.currentIndex++
+	return e
+}
+
+//Helper Functions
+func (pq *PriorityQueue) less(i, j int) bool {
+	return pq.comparator.Compare(pq.data[i], pq.data[j]) < 0
+}
+
+func (pq *PriorityQueue) swap(i, j int) {
+	pq.data[i], pq.data[j] = pq.data[j], pq.data[i]
+}
+
+func (pq *PriorityQueue) heapify() {
+	for i := pq.Size()/2 - 1; i >= 0; i-- {
+		pq.siftDown(i)
+	}
+}
+
+func (pq *PriorityQueue) siftUp(index int) bool {
+	moved := false
+	for index > 0 {
+		parent := (index - 1) / 2
+		if !pq.less(index, parent) {
+			break
+		}
+
+		pq.swap(index, parent)
+		index = parent
+		moved = true
+	}
+	return moved
+}
+
+func (pq *PriorityQueue) siftDown(index int) {
+	for {
+		smallest := index
+		left, right := 2*index+1, 2*index+2
+
+		if left < pq.Size() && pq.less(left, smallest) {
+			smallest = left
+		}
+		if right < pq.Size() && pq.less(right, smallest) {
+			smallest = right
+		}
+		if smallest == index {
+			return
+		}
+
+		pq.swap(index, smallest)
+		index = smallest
+	}
+}
+
+func (pq *PriorityQueue) fix(index int) {
+	if !pq.siftUp(index) {
+		pq.siftDown(index)
+	}
+}
+
+func (pq *PriorityQueue) removeAt(index int) int {
+	e := pq.data[index]
+	last := pq.Size() - 1
+
+	pq.swap(index, last)
+	pq.data = pq.data[:last]
+
+	if index < last {
+		pq.fix(index)
+	}
+	return e
+}
+
+func (pq *PriorityQueue) find(element int) int {
+	for i, e := range pq.data {
+		if e == element {
+			return i
+		}
+	}
+	return -1
+}
+
+func newPriorityQueueIterator(pq *PriorityQueue) *priorityQueueIterator {
+	return &priorityQueueIterator{
+		currentIndex: 0,
+		pq:           pq,
+	}
+}
@@ -0,0 +1,203 @@
+package queue
+
+import (
+	"github.com/rewantsoni/go-datastructures/list"
+	"github.com/rewantsoni/go-datastructures/operators"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestPriorityQueueOrder(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func() *PriorityQueue
+		expectedResult []int
+	}{
+		{
+			name: "test min priority queue with nil comparator",
+			actualResult: func() *PriorityQueue {
+				return NewPriorityQueue(nil, 5, 3, 8, 1, 9, 2)
+			},
+			expectedResult: []int{1, 2, 3, 5, 8, 9},
+		},
+		{
+			name: "test max priority queue",
+			actualResult: func() *PriorityQueue {
+				return NewPriorityQueue(operators.ReverseOrder{}, 5, 3, 8, 1, 9, 2)
+			},
+			expectedResult: []int{9, 8, 5, 3, 2, 1},
+		},
+		{
+			name: "test priority queue with duplicates through enqueue",
+			actualResult: func() *PriorityQueue {
+				pq := NewPriorityQueue(operators.NaturalOrder{})
+				for _, e := range []int{4, 1, 4, 2, 1} {
+					pq.Enqueue(e)
+				}
+				return pq
+			},
+			expectedResult: []int{1, 1, 2, 4, 4},
+		},
+		{
+			name: "test heapify from list",
+			actualResult: func() *PriorityQueue {
+				return Heapify(list.NewLinkedList(7, 6, 5, 4, 3, 2, 1), operators.NaturalOrder{})
+			},
+			expectedResult: []int{1, 2, 3, 4, 5, 6, 7},
+		},
+		{
+			name: "test heapify from empty list",
+			actualResult: func() *PriorityQueue {
+				return Heapify(list.NewArrayList(), operators.NaturalOrder{})
+			},
+			expectedResult: nil,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			pq := testCase.actualResult()
+			var res []int
+			for !pq.Empty() {
+				assert.Equal(t, pq.Peek(), pq.data[0])
+				res = append(res, pq.Dequeue())
+			}
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func TestPriorityQueueRemoveAndUpdate(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func(pq *PriorityQueue) bool
+		expectedResult bool
+		expectedOrder  []int
+	}{
+		{
+			name: "test remove missing element",
+			actualResult: func(pq *PriorityQueue) bool {
+				return pq.Remove(10)
+			},
+			expectedResult: false,
+			expectedOrder:  []int{1, 2, 3, 4, 5, 6},
+		},
+		{
+			name: "test remove root element",
+			actualResult: func(pq *PriorityQueue) bool {
+				return pq.Remove(1)
+			},
+			expectedResult: true,
+			expectedOrder:  []int{2, 3, 4, 5, 6},
+		},
+		{
+			name: "test remove inner element",
+			actualResult: func(pq *PriorityQueue) bool {
+				return pq.Remove(4)
+			},
+			expectedResult: true,
+			expectedOrder:  []int{1, 2, 3, 5, 6},
+		},
+		{
+			name: "test update to higher priority",
+			actualResult: func(pq *PriorityQueue) bool {
+				return pq.Update(6, 0)
+			},
+			expectedResult: true,
+			expectedOrder:  []int{0, 1, 2, 3, 4, 5},
+		},
+		{
+			name: "test update to lower priority",
+			actualResult: func(pq *PriorityQueue) bool {
+				return pq.Update(1, 10)
+			},
+			expectedResult: true,
+			expectedOrder:  []int{2, 3, 4, 5, 6, 10},
+		},
+		{
+			name: "test update missing element",
+			actualResult: func(pq *PriorityQueue) bool {
+				return pq.Update(7, 0)
+			},
+			expectedResult: false,
+			expectedOrder:  []int{1, 2, 3, 4, 5, 6},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			pq := NewPriorityQueue(nil, 6, 5, 4, 3, 2, 1)
+			assert.Equal(t, testCase.expectedResult, testCase.actualResult(pq))
+
+			var res []int
+			for !pq.Empty() {
+				res = append(res, pq.Dequeue())
+			}
+			assert.Equal(t, testCase.expectedOrder, res)
+		})
+	}
+}
+
+func TestPriorityQueuePanicsOnEmpty(t *testing.T) {
+	testCases := []struct {
+		name         string
+		actualResult func(q Queue) int
+	}{
+		{
+			name: "test dequeue on empty priority queue",
+			actualResult: func(q Queue) int {
+				return q.Dequeue()
+			},
+		},
+		{
+			name: "test peek on empty priority queue",
+			actualResult: func(q Queue) int {
+				return q.Peek()
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("didn't panic on empty priority queue")
+				}
+			}()
+			testCase.actualResult(NewPriorityQueue(nil))
+		})
+	}
+}
+
+func TestPriorityQueueIteratorAndClear(t *testing.T) {
+	pq := NewPriorityQueue(nil, 3, 1, 2)
+	assert.True(t, pq.Contains(2))
+	assert.False(t, pq.Contains(4))
+
+	res := testCollect(pq.Iterator())
+	sort.Ints(res)
+	assert.Equal(t, []int{1, 2, 3}, res)
+
+	pq.Clear()
+	assert.True(t, pq.Empty())
+	assert.Nil(t, testCollect(pq.Iterator()))
+}
+
+func TestPriorityQueueRandomised(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	pq := NewPriorityQueue(nil)
+	var expected []int
+
+	for i := 0; i < 2000; i++ {
+		switch {
+		case r.Intn(3) > 0 || len(expected) == 0:
+			e := r.Intn(100)
+			pq.Enqueue(e)
+			expected = append(expected, e)
+		default:
+			sort.Ints(expected)
+			assert.Equal(t, expected[0], pq.Dequeue())
+			expected = expected[1:]
+		}
+		assert.Equal(t, len(expected), pq.Size())
+	}
+}
@@ -0,0 +1,133 @@
+package queue
+
+import (
+	"fmt"
+	"github.com/rewantsoni/go-datastructures/iterator"
+	"github.com/rewantsoni/go-datastructures/list"
+	"strings"
+)
+
+// Ring keeps the last capacity elements pushed to it; once full, every Push overwrites the oldest element
+// and hands it to the eviction callback, if one was given.
+type Ring struct {
+	head    int
+	size    int
+	data    []int
+	onEvict func(element int)
+}
+
+type ringIterator struct {
+	currentIndex int
+	r            *Ring
+}
+
+func NewRing(capacity int, onEvict func(element int)) *Ring {
+	if capacity <= 0 {
+		return nil
+	}
+
+	return &Ring{
+		head:    nought,
+		size:    nought,
+		data:    make([]int, capacity),
+		onEvict: onEvict,
+	}
+}
+
+// At returns the element index positions after the oldest one.
+func (r *Ring) At(index int) int {
+	if r.Empty() || index < 0 || index >= r.Size() {
+		panic(fmt.Sprintf("panic: index %d is out of bound length is %d", index, r.Size()))
+	}
+
+	return r.data[(r.head+index)%r.Capacity()]
+}
+
+func (r *Ring) Capacity() int {
+	return len(r.data)
+}
+
+func (r *Ring) Clear() {
+	for i := range r.data {
+		r.data[i] = 0
+	}
+	r.head = nought
+	r.size = nought
+}
+
+func (r *Ring) Empty() bool {
+	return r.Size() == 0
+}
+
+func (r *Ring) Full() bool {
+	return r.Size() == r.Capacity()
+}
+
+func (r *Ring) Iterator() iterator.Iterator {
+	return newRingIterator(r)
+}
+
+func (r *Ring) Newest() int {
+	return r.At(r.Size() - 1)
+}
+
+func (r *Ring) Oldest() int {
+	return r.At(0)
+}
+
+func (r *Ring) Push(element int) bool {
+	if !r.Full() {
+		r.data[(r.head+r.size)%r.Capacity()] = element
+		r.size++
+		return true
+	}
+
+	evicted := r.data[r.head]
+	r.data[r.head] = element
+	r.head = (r.head + 1) % r.Capacity()
+
+	if r.onEvict != nil {
+		r.onEvict(evicted)
+	}
+	return true
+}
+
+func (r *Ring) Size() int {
+	return r.size
+}
+
+func (r *Ring) Snapshot() list.List {
+	l := list.NewArrayList()
+	for i := 0; i < r.Size(); i++ {
+		l.Add(r.At(i))
+	}
+	return l
+}
+
+func (r *Ring) String() string {
+	sb := strings.Builder{}
+
+	for i := 0; i < r.Size(); i++ {
+		sb.WriteString(fmt.Sprintf("%d ", r.At(i)))
+	}
+
+	return sb.String()
+}
+
+func (ri *ringIterator) HasNext() bool {
+	return ri.currentIndex < ri.r.Size()
+}
+
+func (ri *ringIterator) Next() int {
+	e := ri.r.At(ri.currentIndex)
+
+	ri.currentIndex++
+	return e
+}
+
+func newRingIterator(r *Ring) *ringIterator {
+	return &ringIterator{
+		currentIndex: 0,
+		r:            r,
+	}
+}
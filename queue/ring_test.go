@@ -0,0 +1,170 @@
+package queue
+
+import (
+	"github.com/rewantsoni/go-datastructures/list"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCreateNewRing(t *testing.T) {
+	testCases := []struct {
+		name           string
+		capacity       int
+		expectedResult *Ring
+	}{
+		{
+			name:           "test create ring with zero capacity",
+			capacity:       0,
+			expectedResult: nil,
+		},
+		{
+			name:     "test create ring with capacity",
+			capacity: 3,
+			expectedResult: &Ring{
+				data: make([]int, 3),
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedResult, NewRing(testCase.capacity, nil))
+		})
+	}
+}
+
+func TestRingPush(t *testing.T) {
+	testCases := []struct {
+		name            string
+		pushes          []int
+		expectedString  string
+		expectedEvicted []int
+		expectedOldest  int
+		expectedNewest  int
+	}{
+		{
+			name:            "test push below capacity",
+			pushes:          []int{1, 2},
+			expectedString:  "1 2 ",
+			expectedEvicted: nil,
+			expectedOldest:  1,
+			expectedNewest:  2,
+		},
+		{
+			name:            "test push up to capacity",
+			pushes:          []int{1, 2, 3},
+			expectedString:  "1 2 3 ",
+			expectedEvicted: nil,
+			expectedOldest:  1,
+			expectedNewest:  3,
+		},
+		{
+			name:            "test push overwrites oldest when full",
+			pushes:          []int{1, 2, 3, 4, 5},
+			expectedString:  "3 4 5 ",
+			expectedEvicted: []int{1, 2},
+			expectedOldest:  3,
+			expectedNewest:  5,
+		},
+		{
+			name:            "test push wraps around more than once",
+			pushes:          []int{1, 2, 3, 4, 5, 6, 7},
+			expectedString:  "5 6 7 ",
+			expectedEvicted: []int{1, 2, 3, 4},
+			expectedOldest:  5,
+			expectedNewest:  7,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var evicted []int
+			r := NewRing(3, func(element int) {
+				evicted = append(evicted, element)
+			})
+			for _, e := range testCase.pushes {
+				assert.True(t, r.Push(e))
+			}
+
+			assert.Equal(t, testCase.expectedString, r.String())
+			assert.Equal(t, testCase.expectedEvicted, evicted)
+			assert.Equal(t, testCase.expectedOldest, r.Oldest())
+			assert.Equal(t, testCase.expectedNewest, r.Newest())
+		})
+	}
+}
+
+func TestRingAt(t *testing.T) {
+	testCases := []struct {
+		name           string
+		index          int
+		expectedResult int
+		expectedPanic  bool
+	}{
+		{
+			name:           "test at oldest index",
+			index:          0,
+			expectedResult: 2,
+		},
+		{
+			name:           "test at newest index",
+			index:          2,
+			expectedResult: 4,
+		},
+		{
+			name:          "test at negative index",
+			index:         -1,
+			expectedPanic: true,
+		},
+		{
+			name:          "test at index past size",
+			index:         3,
+			expectedPanic: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				if (r != nil) != testCase.expectedPanic {
+					t.Errorf("At() paniced when it didn't expect to panic")
+				}
+			}()
+
+			r := NewRing(3, nil)
+			for _, e := range []int{1, 2, 3, 4} {
+				r.Push(e)
+			}
+
+			res := r.At(testCase.index)
+			if !testCase.expectedPanic {
+				assert.Equal(t, testCase.expectedResult, res)
+			}
+		})
+	}
+}
+
+func TestRingIteratorAndSnapshot(t *testing.T) {
+	r := NewRing(3, nil)
+	assert.Nil(t, testCollect(r.Iterator()))
+	assert.Equal(t, list.NewArrayList(), r.Snapshot())
+
+	for _, e := range []int{1, 2, 3, 4} {
+		r.Push(e)
+	}
+	assert.True(t, r.Full())
+	assert.Equal(t, []int{2, 3, 4}, testCollect(r.Iterator()))
+	assert.Equal(t, list.NewArrayList(2, 3, 4), r.Snapshot())
+
+	r.Clear()
+	assert.True(t, r.Empty())
+	r.Push(5)
+	assert.Equal(t, []int{5}, testCollect(r.Iterator()))
+}
+
+func TestRingPanicsOnEmpty(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Oldest() didn't panic on empty ring")
+		}
+	}()
+	NewRing(1, nil).Oldest()
+}
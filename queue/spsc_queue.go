@@ -0,0 +1,212 @@
+package queue
+
+import (
+	"context"
+	"runtime"
+	"sync/atomic"
+)
+
+const (
+	cacheLineSize = 64
+	spinLimit     = 64
+)
+
+// SPSCQueue is a bounded ring buffer that is safe for exactly one producer goroutine and one consumer
+// goroutine. Each side owns one index and only reads the other's, so no operation ever retries or locks.
+type SPSCQueue struct {
+	_ [cacheLineSize]byte
+
+	// owned by the consumer
+	head       uint64
+	cachedTail uint64
+	_          [cacheLineSize - 16]byte
+
+	// owned by the producer
+	tail       uint64
+	cachedHead uint64
+	_          [cacheLineSize - 16]byte
+
+	mask uint64
+	data []int
+
+	consumerParked int32
+	producerParked int32
+	itemsReady     chan struct{}
+	spaceReady     chan struct{}
+}
+
+func NewSPSCQueue(capacity int) *SPSCQueue {
+	if capacity <= 0 {
+		return nil
+	}
+
+	size := 1
+	for size < capacity {
+		size <<= 1
+	}
+
+	return &SPSCQueue{
+		mask:       uint64(size - 1),
+		data:       make([]int, size),
+		itemsReady: make(chan struct{}, 1),
+		spaceReady: make(chan struct{}, 1),
+	}
+}
+
+func (sq *SPSCQueue) Capacity() int {
+	return len(sq.data)
+}
+
+func (sq *SPSCQueue) DequeueN(buf []int) int {
+	head := sq.head
+	available := sq.cachedTail - head
+	if available < uint64(len(buf)) {
+		sq.cachedTail = atomic.LoadUint64(&sq.tail)
+		available = sq.cachedTail - head
+	}
+
+	n := uint64(len(buf))
+	if available < n {
+		n = available
+	}
+	if n == 0 {
+		return 0
+	}
+
+	for i := uint64(0); i < n; i++ {
+		buf[i] = sq.data[(head+i)&sq.mask]
+	}
+
+	atomic.StoreUint64(&sq.head, head+n)
+	sq.wake(&sq.producerParked, sq.spaceReady)
+	return int(n)
+}
+
+func (sq *SPSCQueue) Empty() bool {
+	return sq.Size() == 0
+}
+
+func (sq *SPSCQueue) EnqueueN(elements []int) int {
+	tail := sq.tail
+	capacity := uint64(len(sq.data))
+	free := capacity - (tail - sq.cachedHead)
+	if free < uint64(len(elements)) {
+		sq.cachedHead = atomic.LoadUint64(&sq.head)
+		free = capacity - (tail - sq.cachedHead)
+	}
+
+	n := uint64(len(elements))
+	if free < n {
+		n = free
+	}
+	if n == 0 {
+		return 0
+	}
+
+	for i := uint64(0); i < n; i++ {
+		sq.data[(tail+i)&sq.mask] = elements[i]
+	}
+
+	atomic.StoreUint64(&sq.tail, tail+n)
+	sq.wake(&sq.consumerParked, sq.itemsReady)
+	return int(n)
+}
+
+// Put spins briefly while the buffer is full and then parks until the consumer frees a slot or ctx is done.
+func (sq *SPSCQueue) Put(ctx context.Context, element int) error {
+	return sq.wait(ctx, &sq.producerParked, sq.spaceReady, func() bool {
+		return sq.TryEnqueue(element)
+	})
+}
+
+// Size is exact only when called from the producer or consumer while the other side is idle.
+func (sq *SPSCQueue) Size() int {
+	// head first: tail never falls behind a head read earlier, but the consumer and producer can both
+	// move between the two loads, so the difference can still exceed the capacity
+	head := atomic.LoadUint64(&sq.head)
+	size := atomic.LoadUint64(&sq.tail) - head
+	if size > uint64(len(sq.data)) {
+		return len(sq.data)
+	}
+	return int(size)
+}
+
+// Take spins briefly while the buffer is empty and then parks until the producer publishes or ctx is done.
+func (sq *SPSCQueue) Take(ctx context.Context) (int, error) {
+	element := -1
+	err := sq.wait(ctx, &sq.consumerParked, sq.itemsReady, func() bool {
+		var ok bool
+		element, ok = sq.TryDequeue()
+		return ok
+	})
+	return element, err
+}
+
+func (sq *SPSCQueue) TryDequeue() (int, bool) {
+	head := sq.head
+	if head == sq.cachedTail {
+		sq.cachedTail = atomic.LoadUint64(&sq.tail)
+		if head == sq.cachedTail {
+			return -1, false
+		}
+	}
+
+	element := sq.data[head&sq.mask]
+	atomic.StoreUint64(&sq.head, head+1)
+	sq.wake(&sq.producerParked, sq.spaceReady)
+	return element, true
+}
+
+func (sq *SPSCQueue) TryEnqueue(element int) bool {
+	tail := sq.tail
+	capacity := uint64(len(sq.data))
+	if tail-sq.cachedHead == capacity {
+		sq.cachedHead = atomic.LoadUint64(&sq.head)
+		if tail-sq.cachedHead == capacity {
+			return false
+		}
+	}
+
+	sq.data[tail&sq.mask] = element
+	atomic.StoreUint64(&sq.tail, tail+1)
+	sq.wake(&sq.consumerParked, sq.itemsReady)
+	return true
+}
+
+//Helper Functions
+func (sq *SPSCQueue) wait(ctx context.Context, parked *int32, ready chan struct{}, try func() bool) error {
+	for i := 0; ; i++ {
+		if try() {
+			return nil
+		}
+
+		if i < spinLimit {
+			runtime.Gosched()
+			continue
+		}
+
+		// publish the intent to park before the final check, so the other side either sees the flag
+		// or we see its update; this is what rules out a lost wake-up
+		atomic.StoreInt32(parked, 1)
+		if try() {
+			atomic.StoreInt32(parked, 0)
+			return nil
+		}
+
+		select {
+		case <-ready:
+		case <-ctx.Done():
+			atomic.StoreInt32(parked, 0)
+			return ctx.Err()
+		}
+	}
+}
+
+func (sq *SPSCQueue) wake(parked *int32, ready chan struct{}) {
+	if atomic.LoadInt32(parked) == 1 && atomic.CompareAndSwapInt32(parked, 1, 0) {
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,309 @@
+package queue
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestCreateNewSPSCQueue(t *testing.T) {
+	testCases := []struct {
+		name             string
+		capacity         int
+		expectedNil      bool
+		expectedCapacity int
+	}{
+		{
+			name:        "test create spsc queue with zero capacity",
+			capacity:    0,
+			expectedNil: true,
+		},
+		{
+			name:             "test create spsc queue with power of two capacity",
+			capacity:         8,
+			expectedCapacity: 8,
+		},
+		{
+			name:             "test create spsc queue rounds capacity up to power of two",
+			capacity:         5,
+			expectedCapacity: 8,
+		},
+		{
+			name:             "test create spsc queue with capacity one",
+			capacity:         1,
+			expectedCapacity: 1,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := NewSPSCQueue(testCase.capacity)
+			if testCase.expectedNil {
+				assert.Nil(t, res)
+				return
+			}
+			assert.Equal(t, testCase.expectedCapacity, res.Capacity())
+			assert.True(t, res.Empty())
+		})
+	}
+}
+
+func TestSPSCQueueNonBlocking(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func() []interface{}
+		expectedResult []interface{}
+	}{
+		{
+			name: "test try dequeue on empty spsc queue",
+			actualResult: func() []interface{} {
+				e, ok := NewSPSCQueue(2).TryDequeue()
+				return []interface{}{e, ok}
+			},
+			expectedResult: []interface{}{-1, false},
+		},
+		{
+			name: "test try enqueue on full spsc queue",
+			actualResult: func() []interface{} {
+				sq := NewSPSCQueue(2)
+				return []interface{}{sq.TryEnqueue(1), sq.TryEnqueue(2), sq.TryEnqueue(3), sq.Size()}
+			},
+			expectedResult: []interface{}{true, true, false, 2},
+		},
+		{
+			name: "test spsc queue keeps fifo order across wrap around",
+			actualResult: func() []interface{} {
+				sq := NewSPSCQueue(2)
+				var res []interface{}
+				for i := 0; i < 5; i++ {
+					sq.TryEnqueue(i)
+					e, _ := sq.TryDequeue()
+					res = append(res, e)
+				}
+				return res
+			},
+			expectedResult: []interface{}{0, 1, 2, 3, 4},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := testCase.actualResult()
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func TestSPSCQueueSingleElementPathsDoNotAllocate(t *testing.T) {
+	sq := NewSPSCQueue(4)
+	ctx := context.Background()
+
+	allocs := testing.AllocsPerRun(100, func() {
+		sq.TryEnqueue(1)
+		sq.TryDequeue()
+		_ = sq.Put(ctx, 2)
+		_, _ = sq.Take(ctx)
+	})
+	assert.Equal(t, float64(0), allocs)
+}
+
+func TestSPSCQueueSizeStaysWithinCapacity(t *testing.T) {
+	sq := NewSPSCQueue(4)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		for i := 0; i < 2000; i++ {
+			for !sq.TryEnqueue(i) {
+				runtime.Gosched()
+			}
+		}
+	}()
+
+	for received := 0; received < 2000; {
+		size := sq.Size()
+		assert.True(t, size >= 0 && size <= sq.Capacity(), "size %d out of range", size)
+		if _, ok := sq.TryDequeue(); ok {
+			received++
+		} else {
+			runtime.Gosched()
+		}
+	}
+	<-done
+}
+
+func TestSPSCQueueBatch(t *testing.T) {
+	testCases := []struct {
+		name             string
+		capacity         int
+		enqueue          []int
+		dequeueBuf       int
+		expectedEnqueued int
+		expectedDequeued []int
+	}{
+		{
+			name:             "test enqueue n stops at capacity",
+			capacity:         4,
+			enqueue:          []int{1, 2, 3, 4, 5, 6},
+			dequeueBuf:       8,
+			expectedEnqueued: 4,
+			expectedDequeued: []int{1, 2, 3, 4},
+		},
+		{
+			name:             "test dequeue n stops at buffer length",
+			capacity:         4,
+			enqueue:          []int{1, 2, 3},
+			dequeueBuf:       2,
+			expectedEnqueued: 3,
+			expectedDequeued: []int{1, 2},
+		},
+		{
+			name:             "test batch on empty input",
+			capacity:         4,
+			enqueue:          nil,
+			dequeueBuf:       2,
+			expectedEnqueued: 0,
+			expectedDequeued: []int{},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			sq := NewSPSCQueue(testCase.capacity)
+			assert.Equal(t, testCase.expectedEnqueued, sq.EnqueueN(testCase.enqueue))
+
+			buf := make([]int, testCase.dequeueBuf)
+			n := sq.DequeueN(buf)
+			assert.Equal(t, testCase.expectedDequeued, buf[:n])
+		})
+	}
+}
+
+func TestSPSCQueueBlocking(t *testing.T) {
+	sq := NewSPSCQueue(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := sq.Take(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	assert.NoError(t, sq.Put(context.Background(), 1))
+	assert.Equal(t, context.Canceled, sq.Put(ctx, 2))
+
+	done := make(chan error)
+	go func() {
+		done <- sq.Put(context.Background(), 2)
+	}()
+
+	e, err := sq.Take(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, e)
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(testWaitTimeout):
+		t.Fatal("Put() was not woken up by Take()")
+	}
+
+	e, err = sq.Take(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, e)
+}
+
+func TestSPSCQueueProducerConsumer(t *testing.T) {
+	const n = 20000
+	sq := NewSPSCQueue(64)
+
+	go func() {
+		batch := make([]int, 0, 16)
+		for i := 0; i < n; i++ {
+			if i%3 == 0 {
+				_ = sq.Put(context.Background(), i)
+				continue
+			}
+
+			batch = append(batch, i)
+			for len(batch) > 0 && (len(batch) == cap(batch) || i == n-1 || i%3 == 2) {
+				n := sq.EnqueueN(batch)
+				if n == 0 {
+					runtime.Gosched()
+				}
+				batch = batch[n:]
+			}
+		}
+	}()
+
+	buf := make([]int, 8)
+	for expected := 0; expected < n; {
+		if expected%2 == 0 {
+			e, err := sq.Take(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, expected, e)
+			expected++
+			continue
+		}
+
+		n := sq.DequeueN(buf)
+		if n == 0 {
+			runtime.Gosched()
+		}
+		for _, e := range buf[:n] {
+			assert.Equal(t, expected, e)
+			expected++
+		}
+	}
+	assert.True(t, sq.Empty())
+}
+
+func BenchmarkSPSCQueue(b *testing.B) {
+	sq := NewSPSCQueue(1024)
+	go func() {
+		for i := 0; i < b.N; i++ {
+			_ = sq.Put(context.Background(), i)
+		}
+	}()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = sq.Take(context.Background())
+	}
+}
+
+func BenchmarkSPSCQueueBatch(b *testing.B) {
+	sq := NewSPSCQueue(1024)
+	go func() {
+		batch := make([]int, 64)
+		for sent := 0; sent < b.N; {
+			n := len(batch)
+			if b.N-sent < n {
+				n = b.N - sent
+			}
+			m := sq.EnqueueN(batch[:n])
+			if m == 0 {
+				runtime.Gosched()
+			}
+			sent += m
+		}
+	}()
+
+	buf := make([]int, 64)
+	for received := 0; received < b.N; {
+		n := sq.DequeueN(buf)
+		if n == 0 {
+			runtime.Gosched()
+		}
+		received += n
+	}
+}
+
+func BenchmarkBufferedChannel(b *testing.B) {
+	ch := make(chan int, 1024)
+	go func() {
+		for i := 0; i < b.N; i++ {
+			ch <- i
+		}
+	}()
+
+	for i := 0; i < b.N; i++ {
+		<-ch
+	}
+}
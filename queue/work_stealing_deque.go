@@ -0,0 +1,131 @@
+package queue
+
+import (
+	"sync/atomic"
+	"unsafe"
+)
+
+type StealResult int
+
+const (
+	StealEmpty StealResult = iota
+	StealAbort
+	StealSuccess
+)
+
+// WorkStealingDeque is the Chase–Lev deque. Only its owner may call PushBottom and PopBottom; any number of
+// thieves may call Steal concurrently. The owner works LIFO at the bottom while thieves take the oldest
+// elements from the top, so the two sides only contend over the last element.
+type WorkStealingDeque struct {
+	top    int64
+	bottom int64
+	array  unsafe.Pointer
+}
+
+type circularArray struct {
+	mask int64
+	data []int64
+}
+
+func NewWorkStealingDeque() *WorkStealingDeque {
+	return &WorkStealingDeque{
+		array: unsafe.Pointer(newCircularArray(initialCapacity)),
+	}
+}
+
+func (wsd *WorkStealingDeque) Empty() bool {
+	return wsd.Size() == 0
+}
+
+func (wsd *WorkStealingDeque) PopBottom() (int, bool) {
+	b := atomic.LoadInt64(&wsd.bottom) - 1
+	a := wsd.loadArray()
+	atomic.StoreInt64(&wsd.bottom, b)
+
+	t := atomic.LoadInt64(&wsd.top)
+	if t > b {
+		atomic.StoreInt64(&wsd.bottom, b+1)
+		return -1, false
+	}
+
+	e := a.get(b)
+	if t < b {
+		return e, true
+	}
+
+	// last element: whoever moves top past it first, this pop or a steal, gets it
+	won := atomic.CompareAndSwapInt64(&wsd.top, t, t+1)
+	atomic.StoreInt64(&wsd.bottom, b+1)
+	if !won {
+		return -1, false
+	}
+	return e, true
+}
+
+func (wsd *WorkStealingDeque) PushBottom(element int) {
+	b := atomic.LoadInt64(&wsd.bottom)
+	t := atomic.LoadInt64(&wsd.top)
+	a := wsd.loadArray()
+
+	if b-t > a.mask {
+		// thieves still holding the old array can keep reading it, nothing in [t, b) is written there again
+		a = a.grow(t, b)
+		atomic.StorePointer(&wsd.array, unsafe.Pointer(a))
+	}
+
+	a.put(b, element)
+	atomic.StoreInt64(&wsd.bottom, b+1)
+}
+
+// Size is approximate while thieves are active.
+func (wsd *WorkStealingDeque) Size() int {
+	size := atomic.LoadInt64(&wsd.bottom) - atomic.LoadInt64(&wsd.top)
+	if size < 0 {
+		return 0
+	}
+	return int(size)
+}
+
+// Steal takes the oldest element. StealAbort means it lost a race with another thief or the owner and may
+// be retried; StealEmpty means there was nothing to take.
+func (wsd *WorkStealingDeque) Steal() (int, StealResult) {
+	t := atomic.LoadInt64(&wsd.top)
+	b := atomic.LoadInt64(&wsd.bottom)
+	if t >= b {
+		return -1, StealEmpty
+	}
+
+	e := wsd.loadArray().get(t)
+	if !atomic.CompareAndSwapInt64(&wsd.top, t, t+1) {
+		return -1, StealAbort
+	}
+	return e, StealSuccess
+}
+
+//Helper Functions
+func (wsd *WorkStealingDeque) loadArray() *circularArray {
+	return (*circularArray)(atomic.LoadPointer(&wsd.array))
+}
+
+func newCircularArray(capacity int64) *circularArray {
+	return &circularArray{
+		mask: capacity - 1,
+		data: make([]int64, capacity),
+	}
+}
+
+func (a *circularArray) get(i int64) int {
+	return int(atomic.LoadInt64(&a.data[i&a.mask]))
+}
+
+func (a *circularArray) put(i int64, element int) {
+	atomic.StoreInt64(&a.data[i&a.mask], int64(element))
+}
+
+func (a *circularArray) grow(top, bottom int64) *circularArray {
+	grown := newCircularArray((a.mask + 1) * scalingFactor)
+	for i := top; i < bottom; i++ {
+		grown.put(i, a.get(i))
+	}
+	return grown
+}
@@ -0,0 +1,183 @@
+package queue
+
+import (
+	"github.com/stretchr/testify/assert"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestWorkStealingDequeSingleThreaded(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func(wsd *WorkStealingDeque) []interface{}
+		expectedResult []interface{}
+	}{
+		{
+			name: "test pop bottom on empty deque",
+			actualResult: func(wsd *WorkStealingDeque) []interface{} {
+				e, ok := wsd.PopBottom()
+				return []interface{}{e, ok, wsd.Size()}
+			},
+			expectedResult: []interface{}{-1, false, 0},
+		},
+		{
+			name: "test steal on empty deque",
+			actualResult: func(wsd *WorkStealingDeque) []interface{} {
+				e, res := wsd.Steal()
+				return []interface{}{e, res}
+			},
+			expectedResult: []interface{}{-1, StealEmpty},
+		},
+		{
+			name: "test pop bottom is lifo",
+			actualResult: func(wsd *WorkStealingDeque) []interface{} {
+				wsd.PushBottom(1)
+				wsd.PushBottom(2)
+				wsd.PushBottom(3)
+				a, _ := wsd.PopBottom()
+				b, _ := wsd.PopBottom()
+				return []interface{}{a, b, wsd.Size()}
+			},
+			expectedResult: []interface{}{3, 2, 1},
+		},
+		{
+			name: "test steal is fifo",
+			actualResult: func(wsd *WorkStealingDeque) []interface{} {
+				wsd.PushBottom(1)
+				wsd.PushBottom(2)
+				wsd.PushBottom(3)
+				a, ra := wsd.Steal()
+				b, rb := wsd.Steal()
+				return []interface{}{a, ra, b, rb}
+			},
+			expectedResult: []interface{}{1, StealSuccess, 2, StealSuccess},
+		},
+		{
+			name: "test pop bottom and steal meet on the last element",
+			actualResult: func(wsd *WorkStealingDeque) []interface{} {
+				wsd.PushBottom(1)
+				wsd.PushBottom(2)
+				a, ra := wsd.Steal()
+				b, okb := wsd.PopBottom()
+				_, okc := wsd.PopBottom()
+				_, rd := wsd.Steal()
+				return []interface{}{a, ra, b, okb, okc, rd, wsd.Empty()}
+			},
+			expectedResult: []interface{}{1, StealSuccess, 2, true, false, StealEmpty, true},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := testCase.actualResult(NewWorkStealingDeque())
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func TestWorkStealingDequeGrow(t *testing.T) {
+	wsd := NewWorkStealingDeque()
+	n := 5 * initialCapacity
+
+	for i := 0; i < n/2; i++ {
+		wsd.PushBottom(i)
+	}
+	// move top forward so the grown array has to copy a wrapped range
+	for i := 0; i < n/4; i++ {
+		e, res := wsd.Steal()
+		assert.Equal(t, StealSuccess, res)
+		assert.Equal(t, i, e)
+	}
+	for i := n / 2; i < n; i++ {
+		wsd.PushBottom(i)
+	}
+
+	assert.Equal(t, n-n/4, wsd.Size())
+	for i := n - 1; i >= n/4; i-- {
+		e, ok := wsd.PopBottom()
+		assert.True(t, ok)
+		assert.Equal(t, i, e)
+	}
+	assert.True(t, wsd.Empty())
+}
+
+func TestWorkStealingDequeConcurrentThieves(t *testing.T) {
+	const n, thieves = 20000, 4
+
+	wsd := NewWorkStealingDeque()
+	seen := make([]int32, n)
+	var taken int64
+	var done int32
+
+	var wg sync.WaitGroup
+	for i := 0; i < thieves; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				e, res := wsd.Steal()
+				switch res {
+				case StealSuccess:
+					atomic.AddInt32(&seen[e], 1)
+					atomic.AddInt64(&taken, 1)
+				case StealEmpty:
+					if atomic.LoadInt32(&done) == 1 {
+						return
+					}
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		wsd.PushBottom(i)
+		if i%3 == 0 {
+			if e, ok := wsd.PopBottom(); ok {
+				atomic.AddInt32(&seen[e], 1)
+				atomic.AddInt64(&taken, 1)
+			}
+		}
+	}
+	for {
+		e, ok := wsd.PopBottom()
+		if !ok {
+			break
+		}
+		atomic.AddInt32(&seen[e], 1)
+		atomic.AddInt64(&taken, 1)
+	}
+
+	atomic.StoreInt32(&done, 1)
+	wg.Wait()
+
+	assert.Equal(t, int64(n), taken)
+	for e, count := range seen {
+		assert.Equal(t, int32(1), count, "element %d taken %d times", e, count)
+	}
+}
+
+func BenchmarkWorkStealingDeque(b *testing.B) {
+	wsd := NewWorkStealingDeque()
+	var stop int32
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for atomic.LoadInt32(&stop) == 0 {
+			if _, res := wsd.Steal(); res == StealEmpty {
+				runtime.Gosched()
+			}
+		}
+	}()
+
+	for i := 0; i < b.N; i++ {
+		wsd.PushBottom(i)
+		wsd.PopBottom()
+	}
+
+	atomic.StoreInt32(&stop, 1)
+	wg.Wait()
+}
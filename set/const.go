@@ -0,0 +1,5 @@
+package set
+
+const (
+	nought = 0
+)
@@ -0,0 +1,122 @@
+package set
+
+import (
+	"fmt"
+	"github.com/rewantsoni/go-datastructures/hashmap"
+	"github.com/rewantsoni/go-datastructures/iterator"
+	"github.com/rewantsoni/go-datastructures/list"
+	"strings"
+)
+
+// HashSet stores its elements as the keys of a HashMap, so it iterates in no particular order.
+type HashSet struct {
+	hm *hashmap.HashMap
+}
+
+type hashSetIterator struct {
+	it iterator.MapIterator
+}
+
+func NewHashSet(elements ...int) *HashSet {
+	hs := &HashSet{
+		hm: hashmap.NewHashMap(),
+	}
+	hs.AddAll(elements...)
+	return hs
+}
+
+func NewHashSetFromList(l list.List) *HashSet {
+	hs := NewHashSet()
+	addFrom(hs, l)
+	return hs
+}
+
+// Add reports whether element was new to the set.
+func (hs *HashSet) Add(element int) bool {
+	_, existed := hs.hm.Put(element, nought)
+	return !existed
+}
+
+// AddAll reports whether any of the elements was new to the set.
+func (hs *HashSet) AddAll(elements ...int) bool {
+	return addAll(hs, elements...)
+}
+
+func (hs *HashSet) Clear() {
+	hs.hm.Clear()
+}
+
+func (hs *HashSet) Contains(element int) bool {
+	return hs.hm.ContainsKey(element)
+}
+
+func (hs *HashSet) ContainsAll(elements ...int) bool {
+	return containsAll(hs, elements...)
+}
+
+func (hs *HashSet) Difference(other Set) Set {
+	return difference(NewHashSet(), hs, other)
+}
+
+func (hs *HashSet) Empty() bool {
+	return hs.Size() == 0
+}
+
+func (hs *HashSet) Intersection(other Set) Set {
+	if other.Size() < hs.Size() {
+		return intersection(NewHashSet(), other, hs)
+	}
+	return intersection(NewHashSet(), hs, other)
+}
+
+func (hs *HashSet) IsSubsetOf(other Set) bool {
+	return isSubset(hs, other)
+}
+
+func (hs *HashSet) IsSupersetOf(other Set) bool {
+	return isSubset(other, hs)
+}
+
+func (hs *HashSet) Iterator() iterator.Iterator {
+	return &hashSetIterator{
+		it: hs.hm.Iterator(),
+	}
+}
+
+func (hs *HashSet) Remove(element int) bool {
+	_, ok := hs.hm.Delete(element)
+	return ok
+}
+
+func (hs *HashSet) Size() int {
+	return hs.hm.Size()
+}
+
+func (hs *HashSet) String() string {
+	sb := strings.Builder{}
+	for it := hs.Iterator(); it.HasNext(); {
+		sb.WriteString(fmt.Sprintf("%d ", it.Next()))
+	}
+	return sb.String()
+}
+
+func (hs *HashSet) SymmetricDifference(other Set) Set {
+	return symmetricDifference(NewHashSet(), hs, other)
+}
+
+func (hs *HashSet) ToList() list.List {
+	return toList(hs)
+}
+
+func (hs *HashSet) Union(other Set) Set {
+	return union(NewHashSet(), hs, other)
+}
+
+func (hsi *hashSetIterator) HasNext() bool {
+	return hsi.it.HasNext()
+}
+
+func (hsi *hashSetIterator) Next() int {
+	element, _ := hsi.it.Next()
+	return element
+}
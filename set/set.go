@@ -0,0 +1,105 @@
+package set
+
+import (
+	"github.com/rewantsoni/go-datastructures/iterator"
+	"github.com/rewantsoni/go-datastructures/list"
+)
+
+// Set operations that build a new set return one of the receiver's kind, so a TreeSet keeps its ordering.
+type Set interface {
+	Add(element int) bool
+	AddAll(elements ...int) bool
+	Clear()
+	Contains(element int) bool
+	ContainsAll(elements ...int) bool
+	Difference(other Set) Set
+	Empty() bool
+	Intersection(other Set) Set
+	IsSubsetOf(other Set) bool
+	IsSupersetOf(other Set) bool
+	Iterator() iterator.Iterator
+	Remove(element int) bool
+	Size() int
+	SymmetricDifference(other Set) Set
+	ToList() list.List
+	Union(other Set) Set
+}
+
+//Helper Functions
+func addAll(s Set, elements ...int) bool {
+	added := false
+	for _, element := range elements {
+		if s.Add(element) {
+			added = true
+		}
+	}
+	return added
+}
+
+func addFrom(s Set, l list.List) {
+	for it := l.Iterator(); it.HasNext(); {
+		s.Add(it.Next())
+	}
+}
+
+func containsAll(s Set, elements ...int) bool {
+	for _, element := range elements {
+		if !s.Contains(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// addFiltered adds to result every element of from whose membership in other equals inOther.
+func addFiltered(result Set, from Set, other Set, inOther bool) Set {
+	for it := from.Iterator(); it.HasNext(); {
+		element := it.Next()
+		if other.Contains(element) == inOther {
+			result.Add(element)
+		}
+	}
+	return result
+}
+
+func union(result Set, a, b Set) Set {
+	for _, s := range []Set{a, b} {
+		for it := s.Iterator(); it.HasNext(); {
+			result.Add(it.Next())
+		}
+	}
+	return result
+}
+
+func intersection(result Set, a, b Set) Set {
+	return addFiltered(result, a, b, true)
+}
+
+func difference(result Set, a, b Set) Set {
+	return addFiltered(result, a, b, false)
+}
+
+func symmetricDifference(result Set, a, b Set) Set {
+	addFiltered(result, a, b, false)
+	return addFiltered(result, b, a, false)
+}
+
+func isSubset(a, b Set) bool {
+	if a.Size() > b.Size() {
+		return false
+	}
+	for it := a.Iterator(); it.HasNext(); {
+		if !b.Contains(it.Next()) {
+			return false
+		}
+	}
+	return true
+}
+
+func toList(s Set) list.List {
+	l := list.NewArrayList()
+	for it := s.Iterator(); it.HasNext(); {
+		l.Add(it.Next())
+	}
+	return l
+}
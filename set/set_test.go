@@ -0,0 +1,181 @@
+package set
+
+import (
+	"github.com/rewantsoni/go-datastructures/list"
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"testing"
+)
+
+var testSetConstructors = map[string]func(elements ...int) Set{
+	"hash set": func(elements ...int) Set {
+		return NewHashSet(elements...)
+	},
+	"tree set": func(elements ...int) Set {
+		return NewTreeSet(nil, elements...)
+	},
+}
+
+func testSorted(s Set) []int {
+	res := []int{}
+	for it := s.Iterator(); it.HasNext(); {
+		res = append(res, it.Next())
+	}
+	sort.Ints(res)
+	return res
+}
+
+func TestSetOperations(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func(newSet func(elements ...int) Set) []interface{}
+		expectedResult []interface{}
+	}{
+		{
+			name: "test new set drops duplicates",
+			actualResult: func(newSet func(elements ...int) Set) []interface{} {
+				s := newSet(3, 1, 3, 2, 1)
+				return []interface{}{s.Size(), testSorted(s), s.Empty()}
+			},
+			expectedResult: []interface{}{3, []int{1, 2, 3}, false},
+		},
+		{
+			name: "test add and remove report whether the set changed",
+			actualResult: func(newSet func(elements ...int) Set) []interface{} {
+				s := newSet()
+				return []interface{}{s.Add(1), s.Add(1), s.Remove(1), s.Remove(1), s.Empty()}
+			},
+			expectedResult: []interface{}{true, false, true, false, true},
+		},
+		{
+			name: "test add all reports whether any element was new",
+			actualResult: func(newSet func(elements ...int) Set) []interface{} {
+				s := newSet(1, 2)
+				return []interface{}{s.AddAll(1, 2), s.AddAll(2, 3), s.Size()}
+			},
+			expectedResult: []interface{}{false, true, 3},
+		},
+		{
+			name: "test contains and contains all",
+			actualResult: func(newSet func(elements ...int) Set) []interface{} {
+				s := newSet(1, 2, 3)
+				return []interface{}{s.Contains(2), s.Contains(4), s.ContainsAll(1, 3), s.ContainsAll(1, 4), s.ContainsAll()}
+			},
+			expectedResult: []interface{}{true, false, true, false, true},
+		},
+		{
+			name: "test clear",
+			actualResult: func(newSet func(elements ...int) Set) []interface{} {
+				s := newSet(1, 2, 3)
+				s.Clear()
+				return []interface{}{s.Size(), s.Contains(1), testSorted(s)}
+			},
+			expectedResult: []interface{}{0, false, []int{}},
+		},
+	}
+	for name, constructor := range testSetConstructors {
+		for _, testCase := range testCases {
+			t.Run(name+" "+testCase.name, func(t *testing.T) {
+				res := testCase.actualResult(constructor)
+				assert.Equal(t, testCase.expectedResult, res)
+			})
+		}
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func(a, b Set) Set
+		expectedResult []int
+	}{
+		{
+			name:           "test union",
+			actualResult:   Set.Union,
+			expectedResult: []int{1, 2, 3, 4, 5, 6},
+		},
+		{
+			name:           "test intersection",
+			actualResult:   Set.Intersection,
+			expectedResult: []int{3, 4},
+		},
+		{
+			name:           "test difference",
+			actualResult:   Set.Difference,
+			expectedResult: []int{1, 2},
+		},
+		{
+			name:           "test symmetric difference",
+			actualResult:   Set.SymmetricDifference,
+			expectedResult: []int{1, 2, 5, 6},
+		},
+	}
+	// the operands are mixed so that every operation is also run across implementations
+	for aName, newA := range testSetConstructors {
+		for bName, newB := range testSetConstructors {
+			for _, testCase := range testCases {
+				t.Run(aName+" with "+bName+" "+testCase.name, func(t *testing.T) {
+					a, b := newA(1, 2, 3, 4), newB(3, 4, 5, 6)
+					res := testCase.actualResult(a, b)
+					assert.Equal(t, testCase.expectedResult, testSorted(res))
+					assert.Equal(t, []int{1, 2, 3, 4}, testSorted(a), "operands must not change")
+					assert.Equal(t, []int{3, 4, 5, 6}, testSorted(b), "operands must not change")
+				})
+			}
+		}
+	}
+}
+
+func TestSetSubsetAndSuperset(t *testing.T) {
+	testCases := []struct {
+		name           string
+		a              []int
+		b              []int
+		expectedResult []bool
+	}{
+		{
+			name:           "test proper subset",
+			a:              []int{1, 2},
+			b:              []int{1, 2, 3},
+			expectedResult: []bool{true, false},
+		},
+		{
+			name:           "test equal sets are subsets and supersets of each other",
+			a:              []int{1, 2},
+			b:              []int{2, 1},
+			expectedResult: []bool{true, true},
+		},
+		{
+			name:           "test empty set is a subset of everything",
+			a:              []int{},
+			b:              []int{1},
+			expectedResult: []bool{true, false},
+		},
+		{
+			name:           "test overlapping sets are neither",
+			a:              []int{1, 2},
+			b:              []int{2, 3},
+			expectedResult: []bool{false, false},
+		},
+	}
+	for name, constructor := range testSetConstructors {
+		for _, testCase := range testCases {
+			t.Run(name+" "+testCase.name, func(t *testing.T) {
+				a, b := constructor(testCase.a...), constructor(testCase.b...)
+				assert.Equal(t, testCase.expectedResult, []bool{a.IsSubsetOf(b), a.IsSupersetOf(b)})
+			})
+		}
+	}
+}
+
+func TestSetListConversions(t *testing.T) {
+	l := list.NewLinkedList(5, 1, 5, 3)
+
+	hs := NewHashSetFromList(l)
+	assert.Equal(t, []int{1, 3, 5}, testSorted(hs))
+	assert.Equal(t, 3, hs.ToList().Size())
+	assert.True(t, hs.ToList().ContainsAll(1, 3, 5))
+
+	ts := NewTreeSetFromList(l, nil)
+	assert.Equal(t, list.NewArrayList(1, 3, 5), ts.ToList())
+}
@@ -0,0 +1,321 @@
+package set
+
+import (
+	"fmt"
+	"github.com/rewantsoni/go-datastructures/iterator"
+	"github.com/rewantsoni/go-datastructures/list"
+	"github.com/rewantsoni/go-datastructures/operators"
+	"strings"
+)
+
+// TreeSet is an AVL tree, so it iterates in comparator order and every update is O(log n). Elements the
+// comparator considers equal are the same element.
+type TreeSet struct {
+	comparator operators.Comparator
+	root       *avlNode
+	size       int
+}
+
+type avlNode struct {
+	value  int
+	height int
+	left   *avlNode
+	right  *avlNode
+}
+
+type treeSetIterator struct {
+	// nodes whose value is still to be returned, the next one on top
+	path       []*avlNode
+	descending bool
+}
+
+func NewTreeSet(comparator operators.Comparator, elements ...int) *TreeSet {
+	if comparator == nil {
+		comparator = operators.NaturalOrder{}
+	}
+
+	ts := &TreeSet{
+		comparator: comparator,
+	}
+	ts.AddAll(elements...)
+	return ts
+}
+
+func NewTreeSetFromList(l list.List, comparator operators.Comparator) *TreeSet {
+	ts := NewTreeSet(comparator)
+	addFrom(ts, l)
+	return ts
+}
+
+// Add reports whether element was new to the set.
+func (ts *TreeSet) Add(element int) bool {
+	var added bool
+	ts.root, added = ts.insert(ts.root, element)
+	if added {
+		ts.size++
+	}
+	return added
+}
+
+// AddAll reports whether any of the elements was new to the set.
+func (ts *TreeSet) AddAll(elements ...int) bool {
+	return addAll(ts, elements...)
+}
+
+func (ts *TreeSet) Clear() {
+	ts.root = nil
+	ts.size = nought
+}
+
+func (ts *TreeSet) Contains(element int) bool {
+	n := ts.root
+	for n != nil {
+		switch c := ts.comparator.Compare(element, n.value); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+func (ts *TreeSet) ContainsAll(elements ...int) bool {
+	return containsAll(ts, elements...)
+}
+
+// DescendingIterator goes from the last element to the first.
+func (ts *TreeSet) DescendingIterator() iterator.Iterator {
+	return newTreeSetIterator(ts.root, true)
+}
+
+func (ts *TreeSet) Difference(other Set) Set {
+	return difference(ts.empty(), ts, other)
+}
+
+func (ts *TreeSet) Empty() bool {
+	return ts.Size() == 0
+}
+
+func (ts *TreeSet) First() int {
+	if ts.Empty() {
+		panic("set is empty")
+	}
+
+	n := ts.root
+	for n.left != nil {
+		n = n.left
+	}
+	return n.value
+}
+
+func (ts *TreeSet) Intersection(other Set) Set {
+	return intersection(ts.empty(), ts, other)
+}
+
+func (ts *TreeSet) IsSubsetOf(other Set) bool {
+	return isSubset(ts, other)
+}
+
+func (ts *TreeSet) IsSupersetOf(other Set) bool {
+	return isSubset(other, ts)
+}
+
+// Iterator goes from the first element to the last.
+func (ts *TreeSet) Iterator() iterator.Iterator {
+	return newTreeSetIterator(ts.root, false)
+}
+
+func (ts *TreeSet) Last() int {
+	if ts.Empty() {
+		panic("set is empty")
+	}
+
+	n := ts.root
+	for n.right != nil {
+		n = n.right
+	}
+	return n.value
+}
+
+func (ts *TreeSet) Remove(element int) bool {
+	var removed bool
+	ts.root, removed = ts.delete(ts.root, element)
+	if removed {
+		ts.size--
+	}
+	return removed
+}
+
+func (ts *TreeSet) Size() int {
+	return ts.size
+}
+
+func (ts *TreeSet) String() string {
+	sb := strings.Builder{}
+	for it := ts.Iterator(); it.HasNext(); {
+		sb.WriteString(fmt.Sprintf("%d ", it.Next()))
+	}
+	return sb.String()
+}
+
+func (ts *TreeSet) SymmetricDifference(other Set) Set {
+	return symmetricDifference(ts.empty(), ts, other)
+}
+
+// ToList lists the elements in order.
+func (ts *TreeSet) ToList() list.List {
+	return toList(ts)
+}
+
+func (ts *TreeSet) Union(other Set) Set {
+	return union(ts.empty(), ts, other)
+}
+
+func (tsi *treeSetIterator) HasNext() bool {
+	return len(tsi.path) > 0
+}
+
+func (tsi *treeSetIterator) Next() int {
+	if !tsi.HasNext() {
+		panic("panic: tree set iterator is exhausted")
+	}
+
+	n := tsi.path[len(tsi.path)-1]
+	tsi.path = tsi.path[:len(tsi.path)-1]
+	if tsi.descending {
+		tsi.pushEdge(n.left)
+	} else {
+		tsi.pushEdge(n.right)
+	}
+	return n.value
+}
+
+//Helper Functions
+func (ts *TreeSet) empty() *TreeSet {
+	return NewTreeSet(ts.comparator)
+}
+
+func (ts *TreeSet) insert(n *avlNode, element int) (*avlNode, bool) {
+	if n == nil {
+		return &avlNode{value: element, height: 1}, true
+	}
+
+	var added bool
+	switch c := ts.comparator.Compare(element, n.value); {
+	case c < 0:
+		n.left, added = ts.insert(n.left, element)
+	case c > 0:
+		n.right, added = ts.insert(n.right, element)
+	default:
+		return n, false
+	}
+	return rebalance(n), added
+}
+
+func (ts *TreeSet) delete(n *avlNode, element int) (*avlNode, bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var removed bool
+	switch c := ts.comparator.Compare(element, n.value); {
+	case c < 0:
+		n.left, removed = ts.delete(n.left, element)
+	case c > 0:
+		n.right, removed = ts.delete(n.right, element)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+
+		// replace the value with its successor and delete that from the right subtree instead
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		n.value = successor.value
+		n.right, _ = ts.delete(n.right, successor.value)
+		removed = true
+	}
+	return rebalance(n), removed
+}
+
+func height(n *avlNode) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func balance(n *avlNode) int {
+	return height(n.left) - height(n.right)
+}
+
+func updateHeight(n *avlNode) {
+	n.height = 1 + height(n.left)
+	if h := 1 + height(n.right); h > n.height {
+		n.height = h
+	}
+}
+
+func rotateLeft(n *avlNode) *avlNode {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	updateHeight(n)
+	updateHeight(r)
+	return r
+}
+
+func rotateRight(n *avlNode) *avlNode {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	updateHeight(n)
+	updateHeight(l)
+	return l
+}
+
+func rebalance(n *avlNode) *avlNode {
+	updateHeight(n)
+
+	switch b := balance(n); {
+	case b > 1:
+		if balance(n.left) < 0 {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	case b < -1:
+		if balance(n.right) > 0 {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	}
+	return n
+}
+
+func newTreeSetIterator(root *avlNode, descending bool) *treeSetIterator {
+	tsi := &treeSetIterator{
+		descending: descending,
+	}
+	tsi.pushEdge(root)
+	return tsi
+}
+
+// pushEdge pushes n and its chain of left children, or right children when descending.
+func (tsi *treeSetIterator) pushEdge(n *avlNode) {
+	for n != nil {
+		tsi.path = append(tsi.path, n)
+		if tsi.descending {
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+}
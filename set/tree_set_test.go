@@ -0,0 +1,145 @@
+package set
+
+import (
+	"github.com/rewantsoni/go-datastructures/iterator"
+	"github.com/rewantsoni/go-datastructures/operators"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func testCollect(it iterator.Iterator) []int {
+	var res []int
+	for it.HasNext() {
+		res = append(res, it.Next())
+	}
+	return res
+}
+
+func TestTreeSetOrder(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func() []interface{}
+		expectedResult []interface{}
+	}{
+		{
+			name: "test tree set iterates in natural order",
+			actualResult: func() []interface{} {
+				ts := NewTreeSet(nil, 5, 1, 4, 2, 3)
+				return []interface{}{testCollect(ts.Iterator()), testCollect(ts.DescendingIterator()), ts.First(), ts.Last()}
+			},
+			expectedResult: []interface{}{[]int{1, 2, 3, 4, 5}, []int{5, 4, 3, 2, 1}, 1, 5},
+		},
+		{
+			name: "test tree set iterates in comparator order",
+			actualResult: func() []interface{} {
+				ts := NewTreeSet(operators.ReverseOrder{}, 5, 1, 4, 2, 3)
+				return []interface{}{testCollect(ts.Iterator()), ts.First(), ts.Last(), ts.String()}
+			},
+			expectedResult: []interface{}{[]int{5, 4, 3, 2, 1}, 5, 1, "5 4 3 2 1 "},
+		},
+		{
+			name: "test tree set algebra keeps the receiver's comparator",
+			actualResult: func() []interface{} {
+				a := NewTreeSet(operators.ReverseOrder{}, 1, 2, 3)
+				b := NewHashSet(3, 4)
+				return []interface{}{testCollect(a.Union(b).Iterator()), testCollect(a.Difference(b).Iterator())}
+			},
+			expectedResult: []interface{}{[]int{4, 3, 2, 1}, []int{2, 1}},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := testCase.actualResult()
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func TestTreeSetPanics(t *testing.T) {
+	testCases := []struct {
+		name         string
+		actualResult func(ts *TreeSet) int
+	}{
+		{
+			name:         "test first on empty tree set",
+			actualResult: (*TreeSet).First,
+		},
+		{
+			name:         "test last on empty tree set",
+			actualResult: (*TreeSet).Last,
+		},
+		{
+			name: "test exhausted tree set iterator",
+			actualResult: func(ts *TreeSet) int {
+				return ts.Iterator().Next()
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("didn't panic on empty tree set")
+				}
+			}()
+			testCase.actualResult(NewTreeSet(nil))
+		})
+	}
+}
+
+// random inserts and removals must keep the tree ordered and AVL balanced, with heights recorded correctly
+func TestTreeSetStaysBalanced(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	ts := NewTreeSet(nil)
+	expected := map[int]bool{}
+
+	for i := 0; i < 5000; i++ {
+		element := r.Intn(1000)
+		if r.Intn(3) == 0 {
+			assert.Equal(t, expected[element], ts.Remove(element))
+			delete(expected, element)
+		} else {
+			assert.Equal(t, !expected[element], ts.Add(element))
+			expected[element] = true
+		}
+
+		if i%250 == 0 {
+			testCheckAVL(t, ts.root)
+		}
+	}
+	testCheckAVL(t, ts.root)
+
+	var elements []int
+	for element := range expected {
+		elements = append(elements, element)
+	}
+	sort.Ints(elements)
+	assert.Equal(t, elements, testCollect(ts.Iterator()))
+	assert.Equal(t, len(elements), ts.Size())
+}
+
+func testCheckAVL(t *testing.T, n *avlNode) int {
+	if n == nil {
+		return 0
+	}
+
+	if n.left != nil {
+		assert.Less(t, n.left.value, n.value)
+	}
+	if n.right != nil {
+		assert.Greater(t, n.right.value, n.value)
+	}
+
+	l, r := testCheckAVL(t, n.left), testCheckAVL(t, n.right)
+	assert.LessOrEqual(t, l-r, 1)
+	assert.GreaterOrEqual(t, l-r, -1)
+
+	h := l + 1
+	if r >= l {
+		h = r + 1
+	}
+	assert.Equal(t, h, n.height)
+	return h
+}
@@ -0,0 +1,107 @@
+package stack
+
+import "github.com/rewantsoni/go-datastructures/iterator"
+
+// ArrayStack keeps its elements in a growable slice with the top at the end, so pushing allocates only
+// when the slice has to grow.
+type ArrayStack struct {
+	data []int
+}
+
+type arrayStackIterator struct {
+	currentIndex int
+	as           *ArrayStack
+}
+
+func NewArrayStack() Stack {
+	return &ArrayStack{
+		data: make([]int, nought, initialCapacity),
+	}
+}
+
+func (as *ArrayStack) Clear() {
+	as.data = make([]int, nought, initialCapacity)
+}
+
+func (as *ArrayStack) Empty() bool {
+	return as.Size() == 0
+}
+
+// Iterator goes from the top of the stack to the bottom.
+func (as *ArrayStack) Iterator() iterator.Iterator {
+	return newArrayStackIterator(as)
+}
+
+func (as *ArrayStack) Peek() int {
+	e, ok := as.TryPeek()
+	if !ok {
+		panic("stack is empty")
+	}
+	return e
+}
+
+func (as *ArrayStack) Pop() int {
+	e, ok := as.TryPop()
+	if !ok {
+		panic("stack is empty")
+	}
+	return e
+}
+
+func (as *ArrayStack) Push(element int) bool {
+	as.data = append(as.data, element)
+	return true
+}
+
+func (as *ArrayStack) PushAll(elements ...int) bool {
+	as.data = append(as.data, elements...)
+	return true
+}
+
+// Search returns how far below the top element lies, or -1 if it is not on the stack.
+func (as *ArrayStack) Search(element int) int {
+	return search(as.Iterator(), element)
+}
+
+func (as *ArrayStack) Size() int {
+	return len(as.data)
+}
+
+func (as *ArrayStack) TryPeek() (int, bool) {
+	if as.Empty() {
+		return -1, false
+	}
+	return as.data[as.Size()-1], true
+}
+
+func (as *ArrayStack) TryPop() (int, bool) {
+	if as.Empty() {
+		return -1, false
+	}
+
+	last := as.Size() - 1
+	e := as.data[last]
+	as.data = as.data[:last]
+	return e, true
+}
+
+func (asi *arrayStackIterator) HasNext() bool {
+	return asi.currentIndex < asi.as.Size()
+}
+
+func (asi *arrayStackIterator) Next() int {
+	if !asi.HasNext() {
+		panic("panic: array stack iterator is exhausted")
+	}
+
+	e := asi.as.data[asi.as.Size()-1-asi.currentIndex]
+	asi.currentIndex++
+	return e
+}
+
+func newArrayStackIterator(as *ArrayStack) *arrayStackIterator {
+	return &arrayStackIterator{
+		currentIndex: 0,
+		as:           as,
+	}
+}
@@ -0,0 +1,87 @@
+package stack
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCreateNewArrayStack(t *testing.T) {
+	assert.Equal(t, &ArrayStack{data: make([]int, 0, initialCapacity)}, NewArrayStack())
+}
+
+func TestArrayStackOperations(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func(s Stack) []int
+		expectedResult []int
+	}{
+		{
+			name: "test array stack pops in reverse push order",
+			actualResult: func(s Stack) []int {
+				s.Push(1)
+				s.Push(2)
+				s.Push(3)
+				return []int{s.Pop(), s.Pop(), s.Peek(), s.Size()}
+			},
+			expectedResult: []int{3, 2, 1, 1},
+		},
+		{
+			name: "test array stack grows past its initial capacity",
+			actualResult: func(s Stack) []int {
+				for i := 0; i < 2*initialCapacity; i++ {
+					s.Push(i)
+				}
+				return []int{s.Peek(), s.Size()}
+			},
+			expectedResult: []int{2*initialCapacity - 1, 2 * initialCapacity},
+		},
+		{
+			name: "test array stack clear",
+			actualResult: func(s Stack) []int {
+				s.PushAll(1, 2, 3)
+				s.Clear()
+				s.Push(4)
+				return []int{s.Peek(), s.Size()}
+			},
+			expectedResult: []int{4, 1},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := testCase.actualResult(NewArrayStack())
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func TestArrayStackPanicsOnEmpty(t *testing.T) {
+	testCases := []struct {
+		name         string
+		actualResult func(s Stack) int
+	}{
+		{
+			name:         "test pop on empty array stack",
+			actualResult: Stack.Pop,
+		},
+		{
+			name:         "test peek on empty array stack",
+			actualResult: Stack.Peek,
+		},
+		{
+			name: "test iterator past the bottom of array stack",
+			actualResult: func(s Stack) int {
+				return s.Iterator().Next()
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("didn't panic on empty array stack")
+				}
+			}()
+			testCase.actualResult(NewArrayStack())
+		})
+	}
+}
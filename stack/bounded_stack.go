@@ -0,0 +1,230 @@
+package stack
+
+import (
+	"context"
+	"github.com/rewantsoni/go-datastructures/errors"
+	"github.com/rewantsoni/go-datastructures/iterator"
+	"github.com/rewantsoni/go-datastructures/queue"
+	"sync"
+)
+
+type OverflowPolicy int
+
+const (
+	// RejectOnOverflow fails the push with errors.ErrStackFull.
+	RejectOnOverflow OverflowPolicy = iota
+	// DropOldest evicts the bottom element to make room for the new one.
+	DropOldest
+	// BlockOnOverflow waits for a pop to make room.
+	BlockOnOverflow
+)
+
+// BoundedStack never holds more than its capacity. The top of the stack is the front of the deque so that
+// DropOldest can evict from the back.
+type BoundedStack struct {
+	mu         sync.Mutex
+	capacity   int
+	policy     OverflowPolicy
+	onOverflow func(element int)
+	items      *queue.ArrayDeque
+
+	// closed and replaced whenever room is made so that blocked pushes can select on it alongside ctx.Done()
+	notFull chan struct{}
+	// goroutines parked in a blocked push, so tests can wait for a waiter before waking it
+	waiting int
+}
+
+// NewBoundedStack returns nil for a non-positive capacity. onOverflow is optional and receives the element
+// lost to a full stack: the rejected element, the evicted bottom element, or the element whose blocked
+// push gave up. It is called without the stack's lock held.
+func NewBoundedStack(capacity int, policy OverflowPolicy, onOverflow func(element int)) *BoundedStack {
+	if capacity <= 0 {
+		return nil
+	}
+
+	return &BoundedStack{
+		capacity:   capacity,
+		policy:     policy,
+		onOverflow: onOverflow,
+		items:      queue.NewArrayDeque(),
+		notFull:    make(chan struct{}),
+	}
+}
+
+func (bs *BoundedStack) Capacity() int {
+	return bs.capacity
+}
+
+func (bs *BoundedStack) Clear() {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	bs.items.Clear()
+	bs.signalNotFull()
+}
+
+func (bs *BoundedStack) Empty() bool {
+	return bs.Size() == 0
+}
+
+// Iterator goes from the top of the stack to the bottom over a snapshot taken when it is created.
+func (bs *BoundedStack) Iterator() iterator.Iterator {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	snapshot := queue.NewArrayDeque()
+	for it := bs.items.Iterator(); it.HasNext(); {
+		snapshot.AddLast(it.Next())
+	}
+	return snapshot.Iterator()
+}
+
+func (bs *BoundedStack) Peek() int {
+	e, ok := bs.TryPeek()
+	if !ok {
+		panic("stack is empty")
+	}
+	return e
+}
+
+func (bs *BoundedStack) Pop() int {
+	e, ok := bs.TryPop()
+	if !ok {
+		panic("stack is empty")
+	}
+	return e
+}
+
+// Push never waits: under BlockOnOverflow it fails like RejectOnOverflow when the stack is full.
+func (bs *BoundedStack) Push(element int) bool {
+	return bs.push(context.Background(), element, false) == nil
+}
+
+// PushAll pushes all elements or none. It never waits: unless the policy is DropOldest it fails when the
+// stack has no room for all of them, and every element is passed to onOverflow.
+func (bs *BoundedStack) PushAll(elements ...int) bool {
+	bs.mu.Lock()
+	if bs.policy != DropOldest && bs.capacity-bs.items.Size() < len(elements) {
+		bs.mu.Unlock()
+		for _, element := range elements {
+			bs.overflow(element)
+		}
+		return false
+	}
+
+	var evicted []int
+	for _, element := range elements {
+		if bs.items.Size() == bs.capacity {
+			evicted = append(evicted, bs.items.RemoveLast())
+		}
+		bs.items.AddFirst(element)
+	}
+	bs.mu.Unlock()
+
+	for _, element := range evicted {
+		bs.overflow(element)
+	}
+	return true
+}
+
+// PushContext applies the overflow policy, waiting for room under BlockOnOverflow until ctx is done.
+func (bs *BoundedStack) PushContext(ctx context.Context, element int) error {
+	return bs.push(ctx, element, true)
+}
+
+func (bs *BoundedStack) Remaining() int {
+	return bs.capacity - bs.Size()
+}
+
+// Search returns how far below the top element lies, or -1 if it is not on the stack.
+func (bs *BoundedStack) Search(element int) int {
+	return search(bs.Iterator(), element)
+}
+
+func (bs *BoundedStack) Size() int {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	return bs.items.Size()
+}
+
+func (bs *BoundedStack) TryPeek() (int, bool) {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	if bs.items.Empty() {
+		return -1, false
+	}
+	return bs.items.PeekFirst(), true
+}
+
+func (bs *BoundedStack) TryPop() (int, bool) {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	if bs.items.Empty() {
+		return -1, false
+	}
+
+	e := bs.items.RemoveFirst()
+	bs.signalNotFull()
+	return e, true
+}
+
+//Helper Functions
+// push waits for room only when block is set and the policy is BlockOnOverflow.
+func (bs *BoundedStack) push(ctx context.Context, element int, block bool) error {
+	for {
+		bs.mu.Lock()
+		if bs.items.Size() < bs.capacity {
+			bs.items.AddFirst(element)
+			bs.mu.Unlock()
+			return nil
+		}
+
+		switch {
+		case bs.policy == DropOldest:
+			evicted := bs.items.RemoveLast()
+			bs.items.AddFirst(element)
+			bs.mu.Unlock()
+			bs.overflow(evicted)
+			return nil
+
+		case bs.policy == BlockOnOverflow && block:
+			wait := bs.notFull
+			bs.waiting++
+			bs.mu.Unlock()
+
+			var err error
+			select {
+			case <-wait:
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+
+			bs.mu.Lock()
+			bs.waiting--
+			bs.mu.Unlock()
+			if err != nil {
+				bs.overflow(element)
+				return err
+			}
+
+		default:
+			bs.mu.Unlock()
+			bs.overflow(element)
+			return errors.ErrStackFull
+		}
+	}
+}
+
+func (bs *BoundedStack) overflow(element int) {
+	if bs.onOverflow != nil {
+		bs.onOverflow(element)
+	}
+}
+
+func (bs *BoundedStack) signalNotFull() {
+	close(bs.notFull)
+	bs.notFull = make(chan struct{})
+}
@@ -0,0 +1,189 @@
+package stack
+
+import (
+	"context"
+	"github.com/rewantsoni/go-datastructures/errors"
+	"github.com/stretchr/testify/assert"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestCreateNewBoundedStack(t *testing.T) {
+	assert.Nil(t, NewBoundedStack(0, RejectOnOverflow, nil))
+	assert.Nil(t, NewBoundedStack(-1, DropOldest, nil))
+
+	bs := NewBoundedStack(3, BlockOnOverflow, nil)
+	assert.Equal(t, 3, bs.Capacity())
+	assert.Equal(t, 3, bs.Remaining())
+	assert.True(t, bs.Empty())
+}
+
+func TestBoundedStackOverflowPolicies(t *testing.T) {
+	testCases := []struct {
+		name             string
+		policy           OverflowPolicy
+		expectedErr      error
+		expectedElements []int
+		expectedOverflow []int
+	}{
+		{
+			name:             "test reject on overflow keeps the stack and reports the rejected element",
+			policy:           RejectOnOverflow,
+			expectedErr:      errors.ErrStackFull,
+			expectedElements: []int{3, 2, 1},
+			expectedOverflow: []int{4},
+		},
+		{
+			name:             "test drop oldest evicts the bottom element",
+			policy:           DropOldest,
+			expectedErr:      nil,
+			expectedElements: []int{4, 3, 2},
+			expectedOverflow: []int{1},
+		},
+		{
+			name:             "test block on overflow gives up when the context is done",
+			policy:           BlockOnOverflow,
+			expectedErr:      context.Canceled,
+			expectedElements: []int{3, 2, 1},
+			expectedOverflow: []int{4},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var overflowed []int
+			bs := NewBoundedStack(3, testCase.policy, func(element int) {
+				overflowed = append(overflowed, element)
+			})
+			assert.True(t, bs.PushAll(1, 2, 3))
+			assert.Equal(t, 0, bs.Remaining())
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			assert.Equal(t, testCase.expectedErr, bs.PushContext(ctx, 4))
+
+			var res []int
+			for it := bs.Iterator(); it.HasNext(); {
+				res = append(res, it.Next())
+			}
+			assert.Equal(t, testCase.expectedElements, res)
+			assert.Equal(t, testCase.expectedOverflow, overflowed)
+		})
+	}
+}
+
+func TestBoundedStackPushDoesNotWait(t *testing.T) {
+	bs := NewBoundedStack(1, BlockOnOverflow, nil)
+	assert.True(t, bs.Push(1))
+	assert.False(t, bs.Push(2))
+	assert.Equal(t, 1, bs.Peek())
+}
+
+func TestBoundedStackPushAll(t *testing.T) {
+	testCases := []struct {
+		name             string
+		policy           OverflowPolicy
+		expectedResult   bool
+		expectedElements []int
+		expectedOverflow []int
+	}{
+		{
+			name:             "test push all pushes nothing when it does not fit under reject on overflow",
+			policy:           RejectOnOverflow,
+			expectedResult:   false,
+			expectedElements: []int{1},
+			expectedOverflow: []int{2, 3, 4},
+		},
+		{
+			name:             "test push all does not wait or push partially under block on overflow",
+			policy:           BlockOnOverflow,
+			expectedResult:   false,
+			expectedElements: []int{1},
+			expectedOverflow: []int{2, 3, 4},
+		},
+		{
+			name:             "test push all evicts the bottom elements under drop oldest",
+			policy:           DropOldest,
+			expectedResult:   true,
+			expectedElements: []int{4, 3, 2},
+			expectedOverflow: []int{1},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var overflowed []int
+			bs := NewBoundedStack(3, testCase.policy, func(element int) {
+				overflowed = append(overflowed, element)
+			})
+			assert.True(t, bs.Push(1))
+			assert.Equal(t, testCase.expectedResult, bs.PushAll(2, 3, 4))
+
+			var res []int
+			for it := bs.Iterator(); it.HasNext(); {
+				res = append(res, it.Next())
+			}
+			assert.Equal(t, testCase.expectedElements, res)
+			assert.Equal(t, testCase.expectedOverflow, overflowed)
+		})
+	}
+}
+
+func TestBoundedStackBlockedPushResumesAfterPop(t *testing.T) {
+	bs := NewBoundedStack(1, BlockOnOverflow, nil)
+	bs.Push(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	done := make(chan error)
+	go func() {
+		done <- bs.PushContext(ctx, 2)
+	}()
+	testAwaitWaiting(t, bs, 1)
+
+	select {
+	case err := <-done:
+		t.Fatalf("push returned %v before there was room", err)
+	default:
+	}
+
+	assert.Equal(t, 1, bs.Pop())
+	assert.NoError(t, <-done)
+	assert.Equal(t, 2, bs.Peek())
+}
+
+func TestBoundedStackClearWakesBlockedPush(t *testing.T) {
+	bs := NewBoundedStack(2, BlockOnOverflow, nil)
+	bs.PushAll(1, 2)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	done := make(chan error)
+	go func() {
+		done <- bs.PushContext(ctx, 3)
+	}()
+	testAwaitWaiting(t, bs, 1)
+
+	bs.Clear()
+	assert.NoError(t, <-done)
+	assert.Equal(t, []int{1, 3}, []int{bs.Size(), bs.Peek()})
+}
+
+// testAwaitWaiting waits until n goroutines are parked in a blocked push on bs.
+func testAwaitWaiting(t *testing.T, bs *BoundedStack, n int) {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		bs.mu.Lock()
+		waiting := bs.waiting
+		bs.mu.Unlock()
+
+		if waiting >= n {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d goroutines are parked on the bounded stack, expected %d", waiting, n)
+		}
+		runtime.Gosched()
+	}
+}
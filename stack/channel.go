@@ -0,0 +1,44 @@
+package stack
+
+import "context"
+
+// ToChannel sends the elements of s on the returned channel from the top down, popping each one only once a
+// receiver has taken it, and closes the channel when s is empty or ctx is done. The caller must not touch s
+// until the channel is closed.
+func ToChannel(ctx context.Context, s Stack) <-chan int {
+	ch := make(chan int)
+
+	go func() {
+		defer close(ch)
+
+		for !s.Empty() {
+			select {
+			case ch <- s.Peek():
+				s.Pop()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// FromChannel pushes everything received on ch until it is closed or ctx is done, and returns the number
+// of elements pushed along with ctx.Err() if it stopped early.
+func FromChannel(ctx context.Context, ch <-chan int, s Stack) (int, error) {
+	n := 0
+	for {
+		select {
+		case e, ok := <-ch:
+			if !ok {
+				return n, nil
+			}
+			if s.Push(e) {
+				n++
+			}
+		case <-ctx.Done():
+			return n, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package stack
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestToChannel(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	s := NewStack()
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+
+	var res []int
+	for e := range ToChannel(context.Background(), s) {
+		res = append(res, e)
+	}
+
+	assert.Equal(t, []int{3, 2, 1}, res)
+	assert.True(t, s.Empty())
+	testNoGoroutineLeak(t, before)
+}
+
+func TestToChannelCancelled(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	s := NewArrayDequeStack()
+	s.Push(1)
+	s.Push(2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for range ToChannel(ctx, s) {
+	}
+
+	assert.GreaterOrEqual(t, s.Size(), 1)
+	testNoGoroutineLeak(t, before)
+}
+
+func TestFromChannel(t *testing.T) {
+	ch := make(chan int, 2)
+	ch <- 1
+	ch <- 2
+	close(ch)
+
+	s := NewStack()
+	n, err := FromChannel(context.Background(), ch, s)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, 2, s.Peek())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	n, err = FromChannel(ctx, make(chan int), s)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 0, n)
+}
+
+func testNoGoroutineLeak(t *testing.T, before int) {
+	deadline := time.Now().Add(5 * time.Second)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			t.Fatalf("goroutine leak: %d goroutines running, expected at most %d", runtime.NumGoroutine(), before)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
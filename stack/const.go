@@ -0,0 +1,6 @@
+package stack
+
+const (
+	initialCapacity = 16
+	nought          = 0
+)
@@ -0,0 +1,96 @@
+package stack
+
+import (
+	"github.com/rewantsoni/go-datastructures/iterator"
+	"github.com/rewantsoni/go-datastructures/list"
+	"github.com/rewantsoni/go-datastructures/queue"
+)
+
+type DequeStack struct {
+	dq queue.Deque
+}
+
+func NewStack() Stack {
+	return NewLinkedStack()
+}
+
+func NewLinkedStack() Stack {
+	ll := list.NewLinkedList()
+	return &DequeStack{
+		dq: ll,
+	}
+}
+
+func NewArrayDequeStack() Stack {
+	ad := queue.NewArrayDeque()
+	return &DequeStack{
+		dq: ad,
+	}
+}
+
+func (s *DequeStack) Clear() {
+	s.dq.Clear()
+}
+
+func (s *DequeStack) Empty() bool {
+	return s.dq.Size() == 0
+}
+
+// Iterator goes from the top of the stack to the bottom.
+func (s *DequeStack) Iterator() iterator.Iterator {
+	return s.dq.Iterator()
+}
+
+func (s *DequeStack) Peek() int {
+	return s.dq.PeekFirst()
+}
+
+func (s *DequeStack) Pop() int {
+	return s.dq.RemoveFirst()
+}
+
+func (s *DequeStack) Push(element int) bool {
+	return s.dq.AddFirst(element)
+}
+
+func (s *DequeStack) PushAll(elements ...int) bool {
+	for _, element := range elements {
+		if !s.Push(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// Search returns how far below the top element lies, or -1 if it is not on the stack.
+func (s *DequeStack) Search(element int) int {
+	return search(s.Iterator(), element)
+}
+
+func (s *DequeStack) Size() int {
+	return s.dq.Size()
+}
+
+func (s *DequeStack) TryPeek() (int, bool) {
+	if s.Empty() {
+		return -1, false
+	}
+	return s.Peek(), true
+}
+
+func (s *DequeStack) TryPop() (int, bool) {
+	if s.Empty() {
+		return -1, false
+	}
+	return s.Pop(), true
+}
+
+//Helper Functions
+func search(it iterator.Iterator, element int) int {
+	for distance := 0; it.HasNext(); distance++ {
+		if it.Next() == element {
+			return distance
+		}
+	}
+	return -1
+}
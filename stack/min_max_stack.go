@@ -0,0 +1,175 @@
+package stack
+
+import (
+	"github.com/rewantsoni/go-datastructures/iterator"
+	"github.com/rewantsoni/go-datastructures/operators"
+)
+
+// MinMaxStack stores, next to every element, the minimum, maximum and monoid aggregate of the elements
+// at or below it, so all three can be read off the top in O(1).
+type MinMaxStack struct {
+	frames []frame
+	monoid operators.Monoid
+}
+
+type frame struct {
+	value int
+	min   int
+	max   int
+	agg   int
+}
+
+type minMaxStackIterator struct {
+	currentIndex int
+	ms           *MinMaxStack
+}
+
+// NewMinMaxStack takes an optional monoid; without one Aggregate panics.
+func NewMinMaxStack(monoid operators.Monoid) *MinMaxStack {
+	return &MinMaxStack{
+		frames: make([]frame, nought, initialCapacity),
+		monoid: monoid,
+	}
+}
+
+func (ms *MinMaxStack) Aggregate() int {
+	if ms.monoid == nil {
+		panic("stack has no monoid to aggregate with")
+	}
+	if ms.Empty() {
+		return ms.monoid.Identity()
+	}
+	return ms.top().agg
+}
+
+func (ms *MinMaxStack) Clear() {
+	ms.frames = make([]frame, nought, initialCapacity)
+}
+
+func (ms *MinMaxStack) Empty() bool {
+	return ms.Size() == 0
+}
+
+// Iterator goes from the top of the stack to the bottom.
+func (ms *MinMaxStack) Iterator() iterator.Iterator {
+	return newMinMaxStackIterator(ms)
+}
+
+func (ms *MinMaxStack) Max() int {
+	if ms.Empty() {
+		panic("stack is empty")
+	}
+	return ms.top().max
+}
+
+func (ms *MinMaxStack) Min() int {
+	if ms.Empty() {
+		panic("stack is empty")
+	}
+	return ms.top().min
+}
+
+func (ms *MinMaxStack) Peek() int {
+	e, ok := ms.TryPeek()
+	if !ok {
+		panic("stack is empty")
+	}
+	return e
+}
+
+func (ms *MinMaxStack) Pop() int {
+	e, ok := ms.TryPop()
+	if !ok {
+		panic("stack is empty")
+	}
+	return e
+}
+
+func (ms *MinMaxStack) Push(element int) bool {
+	f := frame{
+		value: element,
+		min:   element,
+		max:   element,
+	}
+	if ms.monoid != nil {
+		f.agg = ms.monoid.Combine(ms.monoid.Identity(), element)
+	}
+
+	if !ms.Empty() {
+		below := ms.top()
+		if below.min < f.min {
+			f.min = below.min
+		}
+		if below.max > f.max {
+			f.max = below.max
+		}
+		if ms.monoid != nil {
+			f.agg = ms.monoid.Combine(below.agg, element)
+		}
+	}
+
+	ms.frames = append(ms.frames, f)
+	return true
+}
+
+func (ms *MinMaxStack) PushAll(elements ...int) bool {
+	for _, element := range elements {
+		if !ms.Push(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// Search returns how far below the top element lies, or -1 if it is not on the stack.
+func (ms *MinMaxStack) Search(element int) int {
+	return search(ms.Iterator(), element)
+}
+
+func (ms *MinMaxStack) Size() int {
+	return len(ms.frames)
+}
+
+func (ms *MinMaxStack) TryPeek() (int, bool) {
+	if ms.Empty() {
+		return -1, false
+	}
+	return ms.top().value, true
+}
+
+func (ms *MinMaxStack) TryPop() (int, bool) {
+	if ms.Empty() {
+		return -1, false
+	}
+
+	last := ms.Size() - 1
+	e := ms.frames[last].value
+	ms.frames = ms.frames[:last]
+	return e, true
+}
+
+func (msi *minMaxStackIterator) HasNext() bool {
+	return msi.currentIndex < msi.ms.Size()
+}
+
+func (msi *minMaxStackIterator) Next() int {
+	if !msi.HasNext() {
+		panic("panic: min max stack iterator is exhausted")
+	}
+
+	e := msi.ms.frames[msi.ms.Size()-1-msi.currentIndex].value
+	msi.currentIndex++
+	return e
+}
+
+//Helper Functions
+func (ms *MinMaxStack) top() frame {
+	return ms.frames[ms.Size()-1]
+}
+
+func newMinMaxStackIterator(ms *MinMaxStack) *minMaxStackIterator {
+	return &minMaxStackIterator{
+		currentIndex: 0,
+		ms:           ms,
+	}
+}
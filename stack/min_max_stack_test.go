@@ -0,0 +1,140 @@
+package stack
+
+import (
+	"github.com/rewantsoni/go-datastructures/operators"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMinMaxStackQueries(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func() []int
+		expectedResult []int
+	}{
+		{
+			name: "test min max and sum after pushing elements",
+			actualResult: func() []int {
+				s := NewMinMaxStack(operators.Sum{})
+				s.PushAll(5, 2, 8, 3)
+				return []int{s.Min(), s.Max(), s.Aggregate()}
+			},
+			expectedResult: []int{2, 8, 18},
+		},
+		{
+			name: "test min max and sum are restored after popping",
+			actualResult: func() []int {
+				s := NewMinMaxStack(operators.Sum{})
+				s.PushAll(5, 2, 8, 3)
+				s.Pop()
+				s.Pop()
+				return []int{s.Min(), s.Max(), s.Aggregate()}
+			},
+			expectedResult: []int{2, 5, 7},
+		},
+		{
+			name: "test min and max with repeated minimum",
+			actualResult: func() []int {
+				s := NewMinMaxStack(nil)
+				s.PushAll(3, 1, 1, 4)
+				s.Pop()
+				s.Pop()
+				return []int{s.Min(), s.Max()}
+			},
+			expectedResult: []int{1, 3},
+		},
+		{
+			name: "test gcd aggregate",
+			actualResult: func() []int {
+				s := NewMinMaxStack(operators.GCD{})
+				s.PushAll(12, 18, -8)
+				res := []int{s.Aggregate()}
+				s.Pop()
+				return append(res, s.Aggregate())
+			},
+			expectedResult: []int{2, 6},
+		},
+		{
+			name: "test aggregate on empty stack returns identity",
+			actualResult: func() []int {
+				s := NewMinMaxStack(operators.Sum{})
+				s.Push(4)
+				s.Clear()
+				return []int{s.Aggregate(), s.Size()}
+			},
+			expectedResult: []int{0, 0},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := testCase.actualResult()
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func TestMinMaxStackMatchesScan(t *testing.T) {
+	s := NewMinMaxStack(operators.Sum{})
+	var shadow []int
+	ops := []int{7, -3, 9, 0, -1, -1, 12, -1, 4, -1, -1, 6, 6, -1, 2}
+
+	for _, op := range ops {
+		if op == -1 {
+			assert.Equal(t, shadow[len(shadow)-1], s.Pop())
+			shadow = shadow[:len(shadow)-1]
+		} else {
+			s.Push(op)
+			shadow = append(shadow, op)
+		}
+
+		min, max, sum := shadow[0], shadow[0], 0
+		for _, e := range shadow {
+			if e < min {
+				min = e
+			}
+			if e > max {
+				max = e
+			}
+			sum += e
+		}
+		assert.Equal(t, []int{min, max, sum}, []int{s.Min(), s.Max(), s.Aggregate()})
+	}
+}
+
+func TestMinMaxStackPanics(t *testing.T) {
+	testCases := []struct {
+		name         string
+		actualResult func() int
+	}{
+		{
+			name: "test min on empty stack",
+			actualResult: func() int {
+				return NewMinMaxStack(nil).Min()
+			},
+		},
+		{
+			name: "test max on empty stack",
+			actualResult: func() int {
+				return NewMinMaxStack(nil).Max()
+			},
+		},
+		{
+			name: "test aggregate without a monoid",
+			actualResult: func() int {
+				s := NewMinMaxStack(nil)
+				s.Push(1)
+				return s.Aggregate()
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("didn't panic")
+				}
+			}()
+			testCase.actualResult()
+		})
+	}
+}
@@ -1,38 +1,17 @@
 package stack
 
-import "github.com/rewantsoni/go-datastructures/list"
-
-type Stack struct {
-	ll *list.LinkedList
-}
-
-func NewStack() *Stack {
-	ll := list.NewLinkedList()
-	return &Stack{
-		ll: ll,
-	}
-}
-
-func (s *Stack) Clear() {
-	s.ll.Clear()
-}
-
-func (s *Stack) Empty() bool {
-	return s.ll.IsEmpty()
-}
-
-func (s *Stack) Peek() int {
-	return s.ll.GetFirst()
-}
-
-func (s *Stack) Pop() int {
-	return s.ll.RemoveFirst()
-}
-
-func (s *Stack) Push(element int) bool {
-	return s.ll.AddFirst(element)
-}
-
-func (s *Stack) Size() int {
-	return s.ll.Size()
+import "github.com/rewantsoni/go-datastructures/iterator"
+
+type Stack interface {
+	Clear()
+	Empty() bool
+	Iterator() iterator.Iterator
+	Peek() int
+	Pop() int
+	Push(element int) bool
+	PushAll(elements ...int) bool
+	Search(element int) int
+	Size() int
+	TryPeek() (int, bool)
+	TryPop() (int, bool)
 }
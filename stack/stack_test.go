@@ -2,6 +2,7 @@ package stack
 
 import (
 	"github.com/rewantsoni/go-datastructures/list"
+	"github.com/rewantsoni/go-datastructures/queue"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -9,18 +10,30 @@ import (
 func TestCreateNewStack(t *testing.T) {
 	testCases := []struct {
 		name           string
-		actualResult   func() *Stack
-		expectedResult func() *Stack
+		actualResult   func() Stack
+		expectedResult func() Stack
 	}{
 		{
 			name: "test stack create new empty stack",
-			actualResult: func() *Stack {
+			actualResult: func() Stack {
 				return NewStack()
 			},
-			expectedResult: func() *Stack {
+			expectedResult: func() Stack {
 				ll := list.NewLinkedList()
-				return &Stack{
-					ll: ll,
+				return &DequeStack{
+					dq: ll,
+				}
+			},
+		},
+		{
+			name: "test stack create new empty array deque stack",
+			actualResult: func() Stack {
+				return NewArrayDequeStack()
+			},
+			expectedResult: func() Stack {
+				ad := queue.NewArrayDeque()
+				return &DequeStack{
+					dq: ad,
 				}
 			},
 		},
@@ -33,39 +46,78 @@ func TestCreateNewStack(t *testing.T) {
 	}
 }
 
+func TestArrayDequeStack(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func() []int
+		expectedResult []int
+	}{
+		{
+			name: "test array deque stack pops in reverse push order",
+			actualResult: func() []int {
+				s := NewArrayDequeStack()
+				s.Push(1)
+				s.Push(2)
+				s.Push(3)
+				return []int{s.Pop(), s.Peek(), s.Size()}
+			},
+			expectedResult: []int{3, 2, 2},
+		},
+		{
+			name: "test array deque stack clear",
+			actualResult: func() []int {
+				s := NewArrayDequeStack()
+				s.Push(1)
+				s.Clear()
+				if s.Empty() {
+					return []int{s.Size()}
+				}
+				return nil
+			},
+			expectedResult: []int{0},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := testCase.actualResult()
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
 func TestStackClear(t *testing.T) {
 	testCases := []struct {
 		name           string
-		actualResult   func() *Stack
-		expectedResult func() *Stack
+		actualResult   func() Stack
+		expectedResult func() Stack
 	}{
 		{
 			name: "test stack clear with empty stack",
-			actualResult: func() *Stack {
+			actualResult: func() Stack {
 				return NewStack()
 			},
-			expectedResult: func() *Stack {
+			expectedResult: func() Stack {
 				ll := list.NewLinkedList()
-				return &Stack{
-					ll: ll,
+				return &DequeStack{
+					dq: ll,
 				}
 			},
 		},
 		{
 			name: "test stack clear after adding elements",
-			actualResult: func() *Stack {
+			actualResult: func() Stack {
 				s := NewStack()
 				s.Push(1)
 				s.Clear()
 				return s
 			},
-			expectedResult: func() *Stack {
+			expectedResult: func() Stack {
 				return NewStack()
 			},
 		},
 		{
 			name: "test stack clear after adding and removing elements elements",
-			actualResult: func() *Stack {
+			actualResult: func() Stack {
 				s := NewStack()
 				s.Push(1)
 				s.Push(2)
@@ -73,7 +125,7 @@ func TestStackClear(t *testing.T) {
 				s.Clear()
 				return s
 			},
-			expectedResult: func() *Stack {
+			expectedResult: func() Stack {
 				return NewStack()
 			},
 		},
@@ -306,3 +358,127 @@ func TestStackSize(t *testing.T) {
 		})
 	}
 }
+
+var testStackConstructors = map[string]func() Stack{
+	"linked stack":      NewLinkedStack,
+	"array deque stack": NewArrayDequeStack,
+	"array stack":       NewArrayStack,
+	"min max stack": func() Stack {
+		return NewMinMaxStack(nil)
+	},
+	"treiber stack": func() Stack {
+		return NewTreiberStack()
+	},
+	"bounded stack": func() Stack {
+		return NewBoundedStack(initialCapacity, RejectOnOverflow, nil)
+	},
+}
+
+func TestStackTryPopAndTryPeek(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func(s Stack) []interface{}
+		expectedResult []interface{}
+	}{
+		{
+			name: "test try pop and try peek on empty stack",
+			actualResult: func(s Stack) []interface{} {
+				pe, pok := s.TryPeek()
+				e, ok := s.TryPop()
+				return []interface{}{pe, pok, e, ok}
+			},
+			expectedResult: []interface{}{-1, false, -1, false},
+		},
+		{
+			name: "test try pop and try peek after pushing elements",
+			actualResult: func(s Stack) []interface{} {
+				s.Push(1)
+				s.Push(2)
+				pe, pok := s.TryPeek()
+				e, ok := s.TryPop()
+				return []interface{}{pe, pok, e, ok, s.Size()}
+			},
+			expectedResult: []interface{}{2, true, 2, true, 1},
+		},
+	}
+	for name, constructor := range testStackConstructors {
+		for _, testCase := range testCases {
+			t.Run(name+" "+testCase.name, func(t *testing.T) {
+				res := testCase.actualResult(constructor())
+				assert.Equal(t, testCase.expectedResult, res)
+			})
+		}
+	}
+}
+
+func TestStackPushAllAndIterator(t *testing.T) {
+	testCases := []struct {
+		name           string
+		elements       []int
+		expectedResult []int
+	}{
+		{
+			name:           "test push all with no elements",
+			elements:       nil,
+			expectedResult: nil,
+		},
+		{
+			name:           "test push all iterates from the top",
+			elements:       []int{1, 2, 3},
+			expectedResult: []int{3, 2, 1},
+		},
+	}
+	for name, constructor := range testStackConstructors {
+		for _, testCase := range testCases {
+			t.Run(name+" "+testCase.name, func(t *testing.T) {
+				s := constructor()
+				assert.True(t, s.PushAll(testCase.elements...))
+
+				var res []int
+				for it := s.Iterator(); it.HasNext(); {
+					res = append(res, it.Next())
+				}
+				assert.Equal(t, testCase.expectedResult, res)
+				assert.Equal(t, len(testCase.elements), s.Size())
+			})
+		}
+	}
+}
+
+func TestStackSearch(t *testing.T) {
+	testCases := []struct {
+		name           string
+		element        int
+		expectedResult int
+	}{
+		{
+			name:           "test search for the top element",
+			element:        4,
+			expectedResult: 0,
+		},
+		{
+			name:           "test search for the bottom element",
+			element:        1,
+			expectedResult: 3,
+		},
+		{
+			name:           "test search for a repeated element finds the one nearest the top",
+			element:        2,
+			expectedResult: 1,
+		},
+		{
+			name:           "test search for a missing element",
+			element:        5,
+			expectedResult: -1,
+		},
+	}
+	for name, constructor := range testStackConstructors {
+		for _, testCase := range testCases {
+			t.Run(name+" "+testCase.name, func(t *testing.T) {
+				s := constructor()
+				s.PushAll(1, 2, 2, 4)
+				assert.Equal(t, testCase.expectedResult, s.Search(testCase.element))
+			})
+		}
+	}
+}
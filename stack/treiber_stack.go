@@ -0,0 +1,177 @@
+package stack
+
+import (
+	"github.com/rewantsoni/go-datastructures/iterator"
+	"sync/atomic"
+	"unsafe"
+)
+
+// TreiberStack is a lock-free stack that pushes and pops by compare-and-swap on the head pointer. Nodes are
+// immutable once published and never reused, so the garbage collector rules out ABA on the head.
+type TreiberStack struct {
+	head unsafe.Pointer
+}
+
+type treiberNode struct {
+	data  int
+	depth int
+	next  *treiberNode
+}
+
+type treiberStackIterator struct {
+	currNode *treiberNode
+}
+
+func NewTreiberStack() *TreiberStack {
+	return &TreiberStack{}
+}
+
+func (ts *TreiberStack) Clear() {
+	atomic.StorePointer(&ts.head, nil)
+}
+
+func (ts *TreiberStack) Empty() bool {
+	return ts.loadHead() == nil
+}
+
+// Iterator walks the stack as it was when the iterator was created, from the top to the bottom.
+func (ts *TreiberStack) Iterator() iterator.Iterator {
+	return &treiberStackIterator{
+		currNode: ts.loadHead(),
+	}
+}
+
+func (ts *TreiberStack) Peek() int {
+	e, ok := ts.TryPeek()
+	if !ok {
+		panic("stack is empty")
+	}
+	return e
+}
+
+func (ts *TreiberStack) Pop() int {
+	e, ok := ts.TryPop()
+	if !ok {
+		panic("stack is empty")
+	}
+	return e
+}
+
+// PopAll detaches the whole stack with a single CAS and returns its elements from the top to the bottom.
+func (ts *TreiberStack) PopAll() []int {
+	var head *treiberNode
+	for {
+		head = ts.loadHead()
+		if head == nil {
+			return nil
+		}
+		if ts.casHead(head, nil) {
+			break
+		}
+	}
+
+	elements := make([]int, nought, head.depth)
+	for n := head; n != nil; n = n.next {
+		elements = append(elements, n.data)
+	}
+	return elements
+}
+
+func (ts *TreiberStack) Push(element int) bool {
+	n := &treiberNode{data: element}
+	for {
+		head := ts.loadHead()
+		n.next = head
+		n.depth = depth(head) + 1
+		if ts.casHead(head, n) {
+			return true
+		}
+	}
+}
+
+// PushAll publishes all elements with a single CAS, so no other goroutine observes a partial push.
+func (ts *TreiberStack) PushAll(elements ...int) bool {
+	if len(elements) == 0 {
+		return true
+	}
+
+	nodes := make([]treiberNode, len(elements))
+	for i := range nodes {
+		nodes[i].data = elements[i]
+		if i > 0 {
+			nodes[i].next = &nodes[i-1]
+		}
+	}
+
+	top := &nodes[len(nodes)-1]
+	for {
+		head := ts.loadHead()
+		nodes[0].next = head
+		for i := range nodes {
+			nodes[i].depth = depth(head) + i + 1
+		}
+		if ts.casHead(head, top) {
+			return true
+		}
+	}
+}
+
+// Search returns how far below the top element lies, or -1 if it is not on the stack.
+func (ts *TreiberStack) Search(element int) int {
+	return search(ts.Iterator(), element)
+}
+
+// Size reads the depth recorded in the current head, which is exact for that instant but may be stale by
+// the time it is returned while other goroutines are pushing or popping.
+func (ts *TreiberStack) Size() int {
+	return depth(ts.loadHead())
+}
+
+func (ts *TreiberStack) TryPeek() (int, bool) {
+	head := ts.loadHead()
+	if head == nil {
+		return -1, false
+	}
+	return head.data, true
+}
+
+func (ts *TreiberStack) TryPop() (int, bool) {
+	for {
+		head := ts.loadHead()
+		if head == nil {
+			return -1, false
+		}
+		if ts.casHead(head, head.next) {
+			return head.data, true
+		}
+	}
+}
+
+func (tsi *treiberStackIterator) HasNext() bool {
+	return tsi.currNode != nil
+}
+
+func (tsi *treiberStackIterator) Next() int {
+	if tsi.currNode == nil {
+		panic("panic: treiber stack iterator is exhausted")
+	}
+	e := tsi.currNode.data
+	tsi.currNode = tsi.currNode.next
+	return e
+}
+
+//Helper Functions
+func (ts *TreiberStack) loadHead() *treiberNode {
+	return (*treiberNode)(atomic.LoadPointer(&ts.head))
+}
+
+func (ts *TreiberStack) casHead(old, new *treiberNode) bool {
+	return atomic.CompareAndSwapPointer(&ts.head, unsafe.Pointer(old), unsafe.Pointer(new))
+}
+
+func depth(n *treiberNode) int {
+	if n == nil {
+		return 0
+	}
+	return n.depth
+}
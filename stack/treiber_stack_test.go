@@ -0,0 +1,159 @@
+package stack
+
+import (
+	"github.com/stretchr/testify/assert"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestTreiberStack(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func() []interface{}
+		expectedResult []interface{}
+	}{
+		{
+			name: "test treiber stack on new stack",
+			actualResult: func() []interface{} {
+				s := NewTreiberStack()
+				return []interface{}{s.Empty(), s.Size(), s.PopAll()}
+			},
+			expectedResult: []interface{}{true, 0, []int(nil)},
+		},
+		{
+			name: "test treiber stack pop all detaches every element from the top",
+			actualResult: func() []interface{} {
+				s := NewTreiberStack()
+				s.Push(1)
+				s.PushAll(2, 3)
+				s.Push(4)
+				return []interface{}{s.Size(), s.PopAll(), s.Size(), s.Empty()}
+			},
+			expectedResult: []interface{}{4, []int{4, 3, 2, 1}, 0, true},
+		},
+		{
+			name: "test treiber stack size after pops",
+			actualResult: func() []interface{} {
+				s := NewTreiberStack()
+				s.PushAll(1, 2, 3)
+				s.Pop()
+				return []interface{}{s.Size(), s.Peek()}
+			},
+			expectedResult: []interface{}{2, 2},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := testCase.actualResult()
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+// every pushed value must come out exactly once, whether through TryPop or PopAll
+func TestTreiberStackConcurrentPushPop(t *testing.T) {
+	const producers, consumers, perProducer = 4, 4, 5000
+	const total = producers * perProducer
+
+	s := NewTreiberStack()
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i += 2 {
+				if i%10 == 0 {
+					s.PushAll(p*perProducer+i, p*perProducer+i+1)
+					continue
+				}
+				s.Push(p*perProducer + i)
+				s.Push(p*perProducer + i + 1)
+			}
+		}(p)
+	}
+
+	results := make([][]int, consumers)
+	var popped int64
+	var cwg sync.WaitGroup
+	for c := 0; c < consumers; c++ {
+		cwg.Add(1)
+		go func(c int) {
+			defer cwg.Done()
+			for i := 0; atomic.LoadInt64(&popped) < total; i++ {
+				if i%50 == 0 {
+					all := s.PopAll()
+					results[c] = append(results[c], all...)
+					atomic.AddInt64(&popped, int64(len(all)))
+					continue
+				}
+
+				e, ok := s.TryPop()
+				if !ok {
+					runtime.Gosched()
+					continue
+				}
+				results[c] = append(results[c], e)
+				atomic.AddInt64(&popped, 1)
+			}
+		}(c)
+	}
+
+	wg.Wait()
+	cwg.Wait()
+
+	seen := make([]bool, total)
+	for _, res := range results {
+		for _, e := range res {
+			assert.False(t, seen[e], "value %d popped twice", e)
+			seen[e] = true
+		}
+	}
+	for e, ok := range seen {
+		assert.True(t, ok, "value %d was lost", e)
+	}
+	assert.True(t, s.Empty())
+	assert.Equal(t, 0, s.Size())
+}
+
+type testLockedStack struct {
+	mu sync.Mutex
+	s  Stack
+}
+
+func (ls *testLockedStack) Push(element int) bool {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	return ls.s.Push(element)
+}
+
+func (ls *testLockedStack) TryPop() (int, bool) {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	return ls.s.TryPop()
+}
+
+func benchmarkConcurrentStack(b *testing.B, push func(int) bool, tryPop func() (int, bool)) {
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if i%2 == 0 {
+				push(i)
+			} else {
+				tryPop()
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkTreiberStack(b *testing.B) {
+	s := NewTreiberStack()
+	benchmarkConcurrentStack(b, s.Push, s.TryPop)
+}
+
+func BenchmarkLockedLinkedStack(b *testing.B) {
+	s := &testLockedStack{s: NewStack()}
+	benchmarkConcurrentStack(b, s.Push, s.TryPop)
+}
@@ -0,0 +1,113 @@
+package treemap
+
+import "github.com/rewantsoni/go-datastructures/iterator"
+
+// bound limits a view or range on one side; the zero value leaves that side open.
+type bound struct {
+	key       int
+	set       bool
+	inclusive bool
+}
+
+type treeMapIterator struct {
+	next       *node
+	hi         bound
+	lo         bound
+	tm         *TreeMap
+	descending bool
+}
+
+var unbounded = bound{}
+
+func inclusive(key int) bound {
+	return bound{key: key, set: true, inclusive: true}
+}
+
+func exclusive(key int) bound {
+	return bound{key: key, set: true}
+}
+
+func (tmi *treeMapIterator) HasNext() bool {
+	return tmi.next != nil
+}
+
+func (tmi *treeMapIterator) Next() (int, int) {
+	if tmi.next == nil {
+		panic("panic: tree map iterator is exhausted")
+	}
+
+	n := tmi.next
+	if tmi.descending {
+		tmi.next = predecessor(n)
+		if tmi.next != nil && !tmi.tm.aboveLow(tmi.next.key, tmi.lo) {
+			tmi.next = nil
+		}
+	} else {
+		tmi.next = successor(n)
+		if tmi.next != nil && !tmi.tm.belowHigh(tmi.next.key, tmi.hi) {
+			tmi.next = nil
+		}
+	}
+	return n.key, n.value
+}
+
+//Helper Functions
+func (tm *TreeMap) aboveLow(key int, lo bound) bool {
+	if !lo.set {
+		return true
+	}
+	c := tm.comparator.Compare(key, lo.key)
+	return c > 0 || (c == 0 && lo.inclusive)
+}
+
+func (tm *TreeMap) belowHigh(key int, hi bound) bool {
+	if !hi.set {
+		return true
+	}
+	c := tm.comparator.Compare(key, hi.key)
+	return c < 0 || (c == 0 && hi.inclusive)
+}
+
+func (tm *TreeMap) inRange(key int, lo, hi bound) bool {
+	return tm.aboveLow(key, lo) && tm.belowHigh(key, hi)
+}
+
+// lowest returns the first node within lo and hi, or nil if there is none.
+func (tm *TreeMap) lowest(lo, hi bound) *node {
+	n := tm.firstNode()
+	if lo.set {
+		n = tm.search(lo.key, false, lo.inclusive)
+	}
+	if n == nil || !tm.belowHigh(n.key, hi) {
+		return nil
+	}
+	return n
+}
+
+// highest returns the last node within lo and hi, or nil if there is none.
+func (tm *TreeMap) highest(lo, hi bound) *node {
+	n := tm.lastNode()
+	if hi.set {
+		n = tm.search(hi.key, true, hi.inclusive)
+	}
+	if n == nil || !tm.aboveLow(n.key, lo) {
+		return nil
+	}
+	return n
+}
+
+func newTreeMapIterator(tm *TreeMap, lo, hi bound, descending bool) iterator.MapIterator {
+	tmi := &treeMapIterator{
+		lo:         lo,
+		hi:         hi,
+		tm:         tm,
+		descending: descending,
+	}
+
+	if descending {
+		tmi.next = tm.highest(lo, hi)
+	} else {
+		tmi.next = tm.lowest(lo, hi)
+	}
+	return tmi
+}
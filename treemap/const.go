@@ -0,0 +1,8 @@
+package treemap
+
+const (
+	nought = 0
+
+	red   color = false
+	black color = true
+)
@@ -0,0 +1,508 @@
+package treemap
+
+import (
+	"fmt"
+	"github.com/rewantsoni/go-datastructures/iterator"
+	"github.com/rewantsoni/go-datastructures/operators"
+	"strings"
+)
+
+// TreeMap is a red-black tree ordered by its comparator, following the CLRS algorithms with parent links
+// so that iterators and views can step to a node's successor directly.
+type TreeMap struct {
+	comparator operators.Comparator
+	root       *node
+	size       int
+}
+
+// New nodes start out red, the zero value, and colorOf treats a missing child as black.
+type color bool
+
+type node struct {
+	key    int
+	value  int
+	color  color
+	left   *node
+	right  *node
+	parent *node
+}
+
+func NewTreeMap(comparator operators.Comparator) *TreeMap {
+	if comparator == nil {
+		comparator = operators.NaturalOrder{}
+	}
+
+	return &TreeMap{
+		comparator: comparator,
+	}
+}
+
+// Ceiling returns the entry with the least key greater than or equal to key.
+func (tm *TreeMap) Ceiling(key int) (int, int, bool) {
+	return entryOf(tm.ceilingNode(key))
+}
+
+func (tm *TreeMap) Clear() {
+	tm.root = nil
+	tm.size = nought
+}
+
+func (tm *TreeMap) ContainsKey(key int) bool {
+	return tm.find(key) != nil
+}
+
+func (tm *TreeMap) DescendingIterator() iterator.MapIterator {
+	return newTreeMapIterator(tm, unbounded, unbounded, true)
+}
+
+// DescendingRange goes over the keys in [from, to) from the greatest to the least.
+func (tm *TreeMap) DescendingRange(from, to int) iterator.MapIterator {
+	return newTreeMapIterator(tm, inclusive(from), exclusive(to), true)
+}
+
+func (tm *TreeMap) Empty() bool {
+	return tm.Size() == 0
+}
+
+func (tm *TreeMap) First() (int, int, bool) {
+	return entryOf(tm.firstNode())
+}
+
+// Floor returns the entry with the greatest key less than or equal to key.
+func (tm *TreeMap) Floor(key int) (int, int, bool) {
+	return entryOf(tm.floorNode(key))
+}
+
+func (tm *TreeMap) Get(key int) (int, bool) {
+	n := tm.find(key)
+	if n == nil {
+		return -1, false
+	}
+	return n.value, true
+}
+
+// HeadMap is a live view of the keys below to, or up to and including it.
+func (tm *TreeMap) HeadMap(to int, inclusiveTo bool) *View {
+	hi := exclusive(to)
+	if inclusiveTo {
+		hi = inclusive(to)
+	}
+	return newView(tm, unbounded, hi)
+}
+
+// Higher returns the entry with the least key strictly greater than key.
+func (tm *TreeMap) Higher(key int) (int, int, bool) {
+	return entryOf(tm.higherNode(key))
+}
+
+func (tm *TreeMap) Iterator() iterator.MapIterator {
+	return newTreeMapIterator(tm, unbounded, unbounded, false)
+}
+
+func (tm *TreeMap) Last() (int, int, bool) {
+	return entryOf(tm.lastNode())
+}
+
+// Lower returns the entry with the greatest key strictly less than key.
+func (tm *TreeMap) Lower(key int) (int, int, bool) {
+	return entryOf(tm.lowerNode(key))
+}
+
+func (tm *TreeMap) PollFirst() (int, int, bool) {
+	return tm.poll(tm.firstNode())
+}
+
+func (tm *TreeMap) PollLast() (int, int, bool) {
+	return tm.poll(tm.lastNode())
+}
+
+// Put maps key to value and returns the value it replaced, or -1 and false if key is new.
+func (tm *TreeMap) Put(key int, value int) (int, bool) {
+	var parent *node
+	c := 0
+	for n := tm.root; n != nil; {
+		parent = n
+		c = tm.comparator.Compare(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			old := n.value
+			n.value = value
+			return old, true
+		}
+	}
+
+	n := &node{key: key, value: value, parent: parent}
+	switch {
+	case parent == nil:
+		tm.root = n
+	case c < 0:
+		parent.left = n
+	default:
+		parent.right = n
+	}
+	tm.size++
+	tm.fixAfterInsertion(n)
+	return -1, false
+}
+
+// Range goes over the keys in [from, to) from the least to the greatest.
+func (tm *TreeMap) Range(from, to int) iterator.MapIterator {
+	return newTreeMapIterator(tm, inclusive(from), exclusive(to), false)
+}
+
+// Remove deletes key and returns its value, or -1 and false if it was not present.
+func (tm *TreeMap) Remove(key int) (int, bool) {
+	n := tm.find(key)
+	if n == nil {
+		return -1, false
+	}
+
+	value := n.value
+	tm.deleteNode(n)
+	return value, true
+}
+
+func (tm *TreeMap) Size() int {
+	return tm.size
+}
+
+func (tm *TreeMap) String() string {
+	sb := strings.Builder{}
+	for it := tm.Iterator(); it.HasNext(); {
+		key, value := it.Next()
+		sb.WriteString(fmt.Sprintf("%d=%d ", key, value))
+	}
+	return sb.String()
+}
+
+// TailMap is a live view of the keys above from, or from and above it.
+func (tm *TreeMap) TailMap(from int, inclusiveFrom bool) *View {
+	lo := exclusive(from)
+	if inclusiveFrom {
+		lo = inclusive(from)
+	}
+	return newView(tm, lo, unbounded)
+}
+
+//Helper Functions
+func (tm *TreeMap) find(key int) *node {
+	n := tm.root
+	for n != nil {
+		switch c := tm.comparator.Compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+func (tm *TreeMap) firstNode() *node {
+	n := tm.root
+	for n != nil && n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func (tm *TreeMap) lastNode() *node {
+	n := tm.root
+	for n != nil && n.right != nil {
+		n = n.right
+	}
+	return n
+}
+
+// search returns the closest node below key (or at it, if orEqual) when below is set, otherwise the closest
+// node above it.
+func (tm *TreeMap) search(key int, below bool, orEqual bool) *node {
+	var best *node
+	n := tm.root
+	for n != nil {
+		c := tm.comparator.Compare(key, n.key)
+		switch {
+		case c == 0 && orEqual:
+			return n
+		case c > 0 || (c == 0 && !below):
+			if below {
+				best = n
+			}
+			n = n.right
+		default:
+			if !below {
+				best = n
+			}
+			n = n.left
+		}
+	}
+	return best
+}
+
+func (tm *TreeMap) floorNode(key int) *node {
+	return tm.search(key, true, true)
+}
+
+func (tm *TreeMap) lowerNode(key int) *node {
+	return tm.search(key, true, false)
+}
+
+func (tm *TreeMap) ceilingNode(key int) *node {
+	return tm.search(key, false, true)
+}
+
+func (tm *TreeMap) higherNode(key int) *node {
+	return tm.search(key, false, false)
+}
+
+func (tm *TreeMap) poll(n *node) (int, int, bool) {
+	if n == nil {
+		return -1, -1, false
+	}
+
+	key, value := n.key, n.value
+	tm.deleteNode(n)
+	return key, value, true
+}
+
+func (tm *TreeMap) deleteNode(n *node) {
+	tm.size--
+
+	// a node with two children swaps its entry with its successor, which has at most one child
+	if n.left != nil && n.right != nil {
+		s := successor(n)
+		n.key, n.value = s.key, s.value
+		n = s
+	}
+
+	replacement := n.left
+	if replacement == nil {
+		replacement = n.right
+	}
+
+	if replacement != nil {
+		replacement.parent = n.parent
+		tm.replaceChild(n, replacement)
+		n.left, n.right, n.parent = nil, nil, nil
+		if n.color == black {
+			tm.fixAfterDeletion(replacement)
+		}
+		return
+	}
+
+	if n.parent == nil {
+		tm.root = nil
+		return
+	}
+
+	// a leaf is rebalanced while it is still in place and only then detached
+	if n.color == black {
+		tm.fixAfterDeletion(n)
+	}
+	if n.parent != nil {
+		tm.replaceChild(n, nil)
+		n.parent = nil
+	}
+}
+
+func (tm *TreeMap) replaceChild(old, new *node) {
+	switch {
+	case old.parent == nil:
+		tm.root = new
+	case old == old.parent.left:
+		old.parent.left = new
+	default:
+		old.parent.right = new
+	}
+}
+
+func (tm *TreeMap) rotateLeft(n *node) {
+	r := n.right
+	n.right = r.left
+	if r.left != nil {
+		r.left.parent = n
+	}
+	r.parent = n.parent
+	tm.replaceChild(n, r)
+	r.left = n
+	n.parent = r
+}
+
+func (tm *TreeMap) rotateRight(n *node) {
+	l := n.left
+	n.left = l.right
+	if l.right != nil {
+		l.right.parent = n
+	}
+	l.parent = n.parent
+	tm.replaceChild(n, l)
+	l.right = n
+	n.parent = l
+}
+
+func (tm *TreeMap) fixAfterInsertion(n *node) {
+	n.color = red
+
+	for n != tm.root && n.parent.color == red {
+		parent, grandparent := n.parent, n.parent.parent
+		if parent == grandparent.left {
+			uncle := grandparent.right
+			if colorOf(uncle) == red {
+				parent.color, uncle.color, grandparent.color = black, black, red
+				n = grandparent
+				continue
+			}
+			if n == parent.right {
+				n = parent
+				tm.rotateLeft(n)
+			}
+			n.parent.color, n.parent.parent.color = black, red
+			tm.rotateRight(n.parent.parent)
+		} else {
+			uncle := grandparent.left
+			if colorOf(uncle) == red {
+				parent.color, uncle.color, grandparent.color = black, black, red
+				n = grandparent
+				continue
+			}
+			if n == parent.left {
+				n = parent
+				tm.rotateRight(n)
+			}
+			n.parent.color, n.parent.parent.color = black, red
+			tm.rotateLeft(n.parent.parent)
+		}
+	}
+	tm.root.color = black
+}
+
+func (tm *TreeMap) fixAfterDeletion(n *node) {
+	for n != tm.root && colorOf(n) == black {
+		if n == leftOf(n.parent) {
+			sibling := rightOf(n.parent)
+			if colorOf(sibling) == red {
+				setColor(sibling, black)
+				setColor(n.parent, red)
+				tm.rotateLeft(n.parent)
+				sibling = rightOf(n.parent)
+			}
+
+			if colorOf(leftOf(sibling)) == black && colorOf(rightOf(sibling)) == black {
+				setColor(sibling, red)
+				n = n.parent
+				continue
+			}
+
+			if colorOf(rightOf(sibling)) == black {
+				setColor(leftOf(sibling), black)
+				setColor(sibling, red)
+				tm.rotateRight(sibling)
+				sibling = rightOf(n.parent)
+			}
+			setColor(sibling, colorOf(n.parent))
+			setColor(n.parent, black)
+			setColor(rightOf(sibling), black)
+			tm.rotateLeft(n.parent)
+			n = tm.root
+		} else {
+			sibling := leftOf(n.parent)
+			if colorOf(sibling) == red {
+				setColor(sibling, black)
+				setColor(n.parent, red)
+				tm.rotateRight(n.parent)
+				sibling = leftOf(n.parent)
+			}
+
+			if colorOf(rightOf(sibling)) == black && colorOf(leftOf(sibling)) == black {
+				setColor(sibling, red)
+				n = n.parent
+				continue
+			}
+
+			if colorOf(leftOf(sibling)) == black {
+				setColor(rightOf(sibling), black)
+				setColor(sibling, red)
+				tm.rotateLeft(sibling)
+				sibling = leftOf(n.parent)
+			}
+			setColor(sibling, colorOf(n.parent))
+			setColor(n.parent, black)
+			setColor(leftOf(sibling), black)
+			tm.rotateRight(n.parent)
+			n = tm.root
+		}
+	}
+	setColor(n, black)
+}
+
+func colorOf(n *node) color {
+	if n == nil {
+		return black
+	}
+	return n.color
+}
+
+func setColor(n *node, c color) {
+	if n != nil {
+		n.color = c
+	}
+}
+
+func leftOf(n *node) *node {
+	if n == nil {
+		return nil
+	}
+	return n.left
+}
+
+func rightOf(n *node) *node {
+	if n == nil {
+		return nil
+	}
+	return n.right
+}
+
+func successor(n *node) *node {
+	if n.right != nil {
+		n = n.right
+		for n.left != nil {
+			n = n.left
+		}
+		return n
+	}
+
+	p := n.parent
+	for p != nil && n == p.right {
+		n, p = p, p.parent
+	}
+	return p
+}
+
+func predecessor(n *node) *node {
+	if n.left != nil {
+		n = n.left
+		for n.right != nil {
+			n = n.right
+		}
+		return n
+	}
+
+	p := n.parent
+	for p != nil && n == p.left {
+		n, p = p, p.parent
+	}
+	return p
+}
+
+func entryOf(n *node) (int, int, bool) {
+	if n == nil {
+		return -1, -1, false
+	}
+	return n.key, n.value, true
+}
@@ -0,0 +1,333 @@
+package treemap
+
+import (
+	"github.com/rewantsoni/go-datastructures/iterator"
+	"github.com/rewantsoni/go-datastructures/operators"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func testKeys(it iterator.MapIterator) []int {
+	keys := []int{}
+	for it.HasNext() {
+		key, _ := it.Next()
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func testEntry(key, value int, ok bool) []interface{} {
+	return []interface{}{key, value, ok}
+}
+
+func testNewTreeMap(keys ...int) *TreeMap {
+	tm := NewTreeMap(nil)
+	for _, key := range keys {
+		tm.Put(key, key*10)
+	}
+	return tm
+}
+
+func TestTreeMapPutGetRemove(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func(tm *TreeMap) []interface{}
+		expectedResult []interface{}
+	}{
+		{
+			name: "test get and remove on empty tree map",
+			actualResult: func(tm *TreeMap) []interface{} {
+				v, ok := tm.Get(1)
+				r, rok := tm.Remove(1)
+				return []interface{}{v, ok, r, rok, tm.Empty(), tm.ContainsKey(1)}
+			},
+			expectedResult: []interface{}{-1, false, -1, false, true, false},
+		},
+		{
+			name: "test put returns the replaced value",
+			actualResult: func(tm *TreeMap) []interface{} {
+				old, ok := tm.Put(1, 10)
+				replaced, rok := tm.Put(1, 11)
+				v, _ := tm.Get(1)
+				return []interface{}{old, ok, replaced, rok, v, tm.Size()}
+			},
+			expectedResult: []interface{}{-1, false, 10, true, 11, 1},
+		},
+		{
+			name: "test remove returns the removed value",
+			actualResult: func(tm *TreeMap) []interface{} {
+				tm.Put(2, 20)
+				tm.Put(1, 10)
+				tm.Put(3, 30)
+				r, ok := tm.Remove(2)
+				return []interface{}{r, ok, tm.ContainsKey(2), tm.Size(), tm.String()}
+			},
+			expectedResult: []interface{}{20, true, false, 2, "1=10 3=30 "},
+		},
+		{
+			name: "test clear",
+			actualResult: func(tm *TreeMap) []interface{} {
+				tm.Put(1, 10)
+				tm.Clear()
+				return []interface{}{tm.Size(), tm.ContainsKey(1), testKeys(tm.Iterator())}
+			},
+			expectedResult: []interface{}{0, false, []int{}},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := testCase.actualResult(NewTreeMap(nil))
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func TestTreeMapNavigation(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func(tm *TreeMap) []interface{}
+		expectedResult []interface{}
+	}{
+		{
+			name: "test floor",
+			actualResult: func(tm *TreeMap) []interface{} {
+				return append(testEntry(tm.Floor(20)), append(testEntry(tm.Floor(25)), testEntry(tm.Floor(5))...)...)
+			},
+			expectedResult: []interface{}{20, 200, true, 20, 200, true, -1, -1, false},
+		},
+		{
+			name: "test ceiling",
+			actualResult: func(tm *TreeMap) []interface{} {
+				return append(testEntry(tm.Ceiling(20)), append(testEntry(tm.Ceiling(25)), testEntry(tm.Ceiling(45))...)...)
+			},
+			expectedResult: []interface{}{20, 200, true, 30, 300, true, -1, -1, false},
+		},
+		{
+			name: "test lower",
+			actualResult: func(tm *TreeMap) []interface{} {
+				return append(testEntry(tm.Lower(20)), append(testEntry(tm.Lower(25)), testEntry(tm.Lower(10))...)...)
+			},
+			expectedResult: []interface{}{10, 100, true, 20, 200, true, -1, -1, false},
+		},
+		{
+			name: "test higher",
+			actualResult: func(tm *TreeMap) []interface{} {
+				return append(testEntry(tm.Higher(20)), append(testEntry(tm.Higher(15)), testEntry(tm.Higher(40))...)...)
+			},
+			expectedResult: []interface{}{30, 300, true, 20, 200, true, -1, -1, false},
+		},
+		{
+			name: "test first and last",
+			actualResult: func(tm *TreeMap) []interface{} {
+				return append(testEntry(tm.First()), testEntry(tm.Last())...)
+			},
+			expectedResult: []interface{}{10, 100, true, 40, 400, true},
+		},
+		{
+			name: "test poll first and poll last",
+			actualResult: func(tm *TreeMap) []interface{} {
+				res := append(testEntry(tm.PollFirst()), testEntry(tm.PollLast())...)
+				return append(res, testKeys(tm.Iterator()))
+			},
+			expectedResult: []interface{}{10, 100, true, 40, 400, true, []int{20, 30}},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := testCase.actualResult(testNewTreeMap(30, 10, 40, 20))
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func TestTreeMapNavigationOnEmptyMap(t *testing.T) {
+	tm := NewTreeMap(nil)
+	for _, entry := range [][]interface{}{
+		testEntry(tm.First()), testEntry(tm.Last()), testEntry(tm.PollFirst()), testEntry(tm.PollLast()),
+		testEntry(tm.Floor(1)), testEntry(tm.Ceiling(1)), testEntry(tm.Lower(1)), testEntry(tm.Higher(1)),
+	} {
+		assert.Equal(t, []interface{}{-1, -1, false}, entry)
+	}
+}
+
+func TestTreeMapIterators(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func(tm *TreeMap) iterator.MapIterator
+		expectedResult []int
+	}{
+		{
+			name:           "test iterator",
+			actualResult:   (*TreeMap).Iterator,
+			expectedResult: []int{1, 3, 5, 7, 9},
+		},
+		{
+			name:           "test descending iterator",
+			actualResult:   (*TreeMap).DescendingIterator,
+			expectedResult: []int{9, 7, 5, 3, 1},
+		},
+		{
+			name: "test range includes from and excludes to",
+			actualResult: func(tm *TreeMap) iterator.MapIterator {
+				return tm.Range(3, 9)
+			},
+			expectedResult: []int{3, 5, 7},
+		},
+		{
+			name: "test range between keys",
+			actualResult: func(tm *TreeMap) iterator.MapIterator {
+				return tm.Range(2, 8)
+			},
+			expectedResult: []int{3, 5, 7},
+		},
+		{
+			name: "test descending range",
+			actualResult: func(tm *TreeMap) iterator.MapIterator {
+				return tm.DescendingRange(3, 9)
+			},
+			expectedResult: []int{7, 5, 3},
+		},
+		{
+			name: "test empty range",
+			actualResult: func(tm *TreeMap) iterator.MapIterator {
+				return tm.Range(5, 5)
+			},
+			expectedResult: []int{},
+		},
+		{
+			name: "test range outside the keys",
+			actualResult: func(tm *TreeMap) iterator.MapIterator {
+				return tm.DescendingRange(10, 20)
+			},
+			expectedResult: []int{},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := testKeys(testCase.actualResult(testNewTreeMap(5, 1, 9, 3, 7)))
+			assert.Equal(t, testCase.expectedResult, res)
+		})
+	}
+}
+
+func TestTreeMapComparator(t *testing.T) {
+	tm := NewTreeMap(operators.ReverseOrder{})
+	for _, key := range []int{2, 3, 1} {
+		tm.Put(key, key)
+	}
+
+	assert.Equal(t, []int{3, 2, 1}, testKeys(tm.Iterator()))
+	assert.Equal(t, testEntry(2, 2, true), testEntry(tm.Floor(2)))
+	assert.Equal(t, testEntry(1, 1, true), testEntry(tm.Higher(2)))
+	assert.Equal(t, []int{3, 2}, testKeys(tm.Range(3, 1)))
+}
+
+func TestTreeMapIteratorPanicsWhenExhausted(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("didn't panic on exhausted iterator")
+		}
+	}()
+	NewTreeMap(nil).Iterator().Next()
+}
+
+// a random mix of puts and removals must keep every red-black invariant and agree with a builtin map
+func TestTreeMapInvariants(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tm := NewTreeMap(nil)
+	expected := map[int]int{}
+
+	for i := 0; i < 20000; i++ {
+		key := r.Intn(2000)
+		switch r.Intn(5) {
+		case 0, 1:
+			old, ok := tm.Remove(key)
+			eold, eok := expected[key]
+			if !eok {
+				eold = -1
+			}
+			assert.Equal(t, []interface{}{eold, eok}, []interface{}{old, ok})
+			delete(expected, key)
+		case 2:
+			if _, _, ok := tm.PollFirst(); ok {
+				min := -1
+				for k := range expected {
+					if min == -1 || k < min {
+						min = k
+					}
+				}
+				delete(expected, min)
+			}
+		default:
+			tm.Put(key, i)
+			expected[key] = i
+		}
+
+		if i%500 == 0 {
+			testCheckRedBlack(t, tm)
+		}
+	}
+	testCheckRedBlack(t, tm)
+
+	keys := []int{}
+	for key := range expected {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+	assert.Equal(t, keys, testKeys(tm.Iterator()))
+	for _, key := range keys {
+		v, _ := tm.Get(key)
+		assert.Equal(t, expected[key], v)
+	}
+
+	for !tm.Empty() {
+		tm.Remove(tm.root.key)
+		testCheckRedBlack(t, tm)
+	}
+}
+
+func testCheckRedBlack(t *testing.T, tm *TreeMap) {
+	if tm.root == nil {
+		assert.Equal(t, 0, tm.Size())
+		return
+	}
+
+	assert.Equal(t, black, tm.root.color, "root must be black")
+	assert.Nil(t, tm.root.parent)
+
+	size := 0
+	var check func(n *node) int
+	check = func(n *node) int {
+		if n == nil {
+			return 1
+		}
+		size++
+
+		for _, child := range []*node{n.left, n.right} {
+			if child == nil {
+				continue
+			}
+			assert.Equal(t, n, child.parent, "child of %d has the wrong parent", n.key)
+			if n.color == red {
+				assert.Equal(t, black, child.color, "red node %d has a red child", n.key)
+			}
+		}
+		if n.left != nil {
+			assert.Less(t, n.left.key, n.key)
+		}
+		if n.right != nil {
+			assert.Greater(t, n.right.key, n.key)
+		}
+
+		left, right := check(n.left), check(n.right)
+		assert.Equal(t, left, right, "black heights differ below %d", n.key)
+		if n.color == black {
+			return left + 1
+		}
+		return left
+	}
+	check(tm.root)
+	assert.Equal(t, size, tm.Size())
+}
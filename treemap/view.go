@@ -0,0 +1,76 @@
+package treemap
+
+import (
+	"fmt"
+	"github.com/rewantsoni/go-datastructures/iterator"
+)
+
+// View is a live window onto the part of a TreeMap between two bounds: it sees later changes to the map,
+// and changes made through it land in the map. Size walks the window, so it is linear in the view's size.
+type View struct {
+	tm *TreeMap
+	lo bound
+	hi bound
+}
+
+func newView(tm *TreeMap, lo, hi bound) *View {
+	return &View{
+		tm: tm,
+		lo: lo,
+		hi: hi,
+	}
+}
+
+func (v *View) ContainsKey(key int) bool {
+	return v.tm.inRange(key, v.lo, v.hi) && v.tm.ContainsKey(key)
+}
+
+func (v *View) DescendingIterator() iterator.MapIterator {
+	return newTreeMapIterator(v.tm, v.lo, v.hi, true)
+}
+
+func (v *View) Empty() bool {
+	return v.tm.lowest(v.lo, v.hi) == nil
+}
+
+func (v *View) First() (int, int, bool) {
+	return entryOf(v.tm.lowest(v.lo, v.hi))
+}
+
+func (v *View) Get(key int) (int, bool) {
+	if !v.tm.inRange(key, v.lo, v.hi) {
+		return -1, false
+	}
+	return v.tm.Get(key)
+}
+
+func (v *View) Iterator() iterator.MapIterator {
+	return newTreeMapIterator(v.tm, v.lo, v.hi, false)
+}
+
+func (v *View) Last() (int, int, bool) {
+	return entryOf(v.tm.highest(v.lo, v.hi))
+}
+
+// Put panics for a key outside the view, since the entry could never be seen through it.
+func (v *View) Put(key int, value int) (int, bool) {
+	if !v.tm.inRange(key, v.lo, v.hi) {
+		panic(fmt.Sprintf("panic: key %d is out of the view's range", key))
+	}
+	return v.tm.Put(key, value)
+}
+
+func (v *View) Remove(key int) (int, bool) {
+	if !v.tm.inRange(key, v.lo, v.hi) {
+		return -1, false
+	}
+	return v.tm.Remove(key)
+}
+
+func (v *View) Size() int {
+	size := 0
+	for it := v.Iterator(); it.HasNext(); it.Next() {
+		size++
+	}
+	return size
+}
@@ -0,0 +1,115 @@
+package treemap
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTreeMapViews(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actualResult   func(tm *TreeMap) *View
+		expectedKeys   []int
+		expectedFirst  []interface{}
+		expectedLast   []interface{}
+		expectedInView int
+		expectedOut    int
+	}{
+		{
+			name: "test head map excluding to",
+			actualResult: func(tm *TreeMap) *View {
+				return tm.HeadMap(30, false)
+			},
+			expectedKeys:   []int{10, 20},
+			expectedFirst:  []interface{}{10, 100, true},
+			expectedLast:   []interface{}{20, 200, true},
+			expectedInView: 20,
+			expectedOut:    30,
+		},
+		{
+			name: "test head map including to",
+			actualResult: func(tm *TreeMap) *View {
+				return tm.HeadMap(30, true)
+			},
+			expectedKeys:   []int{10, 20, 30},
+			expectedFirst:  []interface{}{10, 100, true},
+			expectedLast:   []interface{}{30, 300, true},
+			expectedInView: 30,
+			expectedOut:    40,
+		},
+		{
+			name: "test tail map excluding from",
+			actualResult: func(tm *TreeMap) *View {
+				return tm.TailMap(20, false)
+			},
+			expectedKeys:   []int{30, 40},
+			expectedFirst:  []interface{}{30, 300, true},
+			expectedLast:   []interface{}{40, 400, true},
+			expectedInView: 40,
+			expectedOut:    20,
+		},
+		{
+			name: "test tail map including from",
+			actualResult: func(tm *TreeMap) *View {
+				return tm.TailMap(20, true)
+			},
+			expectedKeys:   []int{20, 30, 40},
+			expectedFirst:  []interface{}{20, 200, true},
+			expectedLast:   []interface{}{40, 400, true},
+			expectedInView: 20,
+			expectedOut:    10,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			v := testCase.actualResult(testNewTreeMap(10, 20, 30, 40))
+
+			assert.Equal(t, testCase.expectedKeys, testKeys(v.Iterator()))
+			reversed := make([]int, len(testCase.expectedKeys))
+			for i, key := range testCase.expectedKeys {
+				reversed[len(reversed)-1-i] = key
+			}
+			assert.Equal(t, reversed, testKeys(v.DescendingIterator()))
+			assert.Equal(t, testCase.expectedFirst, testEntry(v.First()))
+			assert.Equal(t, testCase.expectedLast, testEntry(v.Last()))
+			assert.Equal(t, len(testCase.expectedKeys), v.Size())
+
+			assert.True(t, v.ContainsKey(testCase.expectedInView))
+			assert.False(t, v.ContainsKey(testCase.expectedOut))
+			_, ok := v.Get(testCase.expectedOut)
+			assert.False(t, ok)
+			_, ok = v.Remove(testCase.expectedOut)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestTreeMapViewIsLive(t *testing.T) {
+	tm := testNewTreeMap(10, 20, 30)
+	v := tm.HeadMap(25, false)
+
+	tm.Put(15, 150)
+	tm.Put(27, 270)
+	assert.Equal(t, []int{10, 15, 20}, testKeys(v.Iterator()))
+
+	v.Put(5, 50)
+	removed, ok := v.Remove(10)
+	assert.Equal(t, []interface{}{100, true}, []interface{}{removed, ok})
+	assert.Equal(t, []int{5, 15, 20, 27, 30}, testKeys(tm.Iterator()))
+
+	for !v.Empty() {
+		key, _, _ := v.First()
+		v.Remove(key)
+	}
+	assert.Equal(t, []int{27, 30}, testKeys(tm.Iterator()))
+	assert.Equal(t, []interface{}{-1, -1, false}, testEntry(v.Last()))
+}
+
+func TestTreeMapViewPutOutOfRangePanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("didn't panic on a put outside the view")
+		}
+	}()
+	NewTreeMap(nil).TailMap(10, true).Put(5, 50)
+}